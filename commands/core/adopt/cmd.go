@@ -0,0 +1,109 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package adopt
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sacloud/autoscaler/commands/flags"
+	"github.com/sacloud/autoscaler/defaults"
+	"github.com/sacloud/autoscaler/grpcutil"
+	sacloudotel "github.com/sacloud/autoscaler/otel"
+	"github.com/sacloud/autoscaler/request"
+	"github.com/sacloud/autoscaler/validate"
+	"github.com/sacloud/go-otelsetup"
+	"github.com/spf13/cobra"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var Command = &cobra.Command{
+	Use:   "adopt [flags]...",
+	Short: "Send Adopt request to Core server to adopt existing servers into the ServerGroup",
+	PreRunE: flags.ValidateMultiFunc(true,
+		flags.ValidateDestinationFlags,
+		func(*cobra.Command, []string) error {
+			return validate.Struct(param)
+		},
+	),
+	RunE: run,
+}
+
+type parameter struct {
+	Source       string `name:"--source" validate:"required,printascii,max=1024"`
+	ResourceName string `name:"--resource-name" validate:"required,printascii,max=1024"`
+	Sync         bool   `name:"--sync"`
+	DryRun       bool   `name:"--dry-run"`
+}
+
+var param = &parameter{
+	Source:       defaults.SourceName,
+	ResourceName: defaults.ResourceName,
+}
+
+func init() {
+	flags.SetDestinationFlag(Command)
+	Command.Flags().StringVarP(&param.ResourceName, "resource-name", "", param.ResourceName, "Name of the target ServerGroup")
+	Command.Flags().StringVarP(&param.Source, "source", "", param.Source, "A string representing the request source, passed to AutoScaler Core")
+	Command.Flags().BoolVarP(&param.Sync, "sync", "", param.Sync, "Flag for synchronous handling")
+	Command.Flags().BoolVarP(&param.DryRun, "dry-run", "", param.DryRun, "Show the servers to be adopted without making any changes")
+}
+
+func run(_ *cobra.Command, _ []string) error {
+	ctx, span := sacloudotel.Tracer().Start(otelsetup.ContextForTrace(context.Background()), "commands/core/adopt#run",
+		trace.WithSpanKind(trace.SpanKindClient),
+	)
+	defer span.End()
+
+	conn, cleanup, err := grpcutil.DialContext(ctx, &grpcutil.DialOption{
+		Destination: flags.Destination(),
+	})
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	client := request.NewScalingServiceClient(conn)
+	res, err := client.Adopt(ctx, &request.AdoptRequest{
+		Source:       param.Source,
+		ResourceName: param.ResourceName,
+		Sync:         param.Sync,
+		DryRun:       param.DryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(res.Candidates) == 0 {
+		fmt.Println("no servers to adopt")
+	}
+	for _, c := range res.Candidates {
+		if c.Reason != "" {
+			fmt.Printf("%s: %s (id: %s, zone: %s) cannot be adopted: %s\n", c.ResourceName, c.CurrentName, c.ServerId, c.Zone, c.Reason)
+			continue
+		}
+		fmt.Printf("%s: %s (id: %s, zone: %s) -> %s\n", c.ResourceName, c.CurrentName, c.ServerId, c.Zone, c.NewName)
+	}
+	if param.DryRun {
+		return nil
+	}
+
+	fmt.Printf("status: %s, job-id: %s", res.Status, res.ScalingJobId)
+	if res.Message != "" {
+		fmt.Printf(", message: %s", res.Message)
+	}
+	fmt.Println()
+	return nil
+}
@@ -15,7 +15,10 @@
 package core
 
 import (
+	"github.com/sacloud/autoscaler/commands/core/adopt"
+	"github.com/sacloud/autoscaler/commands/core/drift"
 	"github.com/sacloud/autoscaler/commands/core/example"
+	"github.com/sacloud/autoscaler/commands/core/lifecycle"
 	"github.com/sacloud/autoscaler/commands/core/resources"
 	"github.com/sacloud/autoscaler/commands/core/start"
 	"github.com/sacloud/autoscaler/commands/core/validate"
@@ -39,6 +42,9 @@ var subCommands = []*cobra.Command{
 	start.Command,
 	validate.Command,
 	resources.Command,
+	drift.Command,
+	lifecycle.Command,
+	adopt.Command,
 }
 
 func init() {
@@ -0,0 +1,93 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sacloud/autoscaler/commands/flags"
+	"github.com/sacloud/autoscaler/defaults"
+	"github.com/sacloud/autoscaler/grpcutil"
+	sacloudotel "github.com/sacloud/autoscaler/otel"
+	"github.com/sacloud/autoscaler/request"
+	"github.com/sacloud/autoscaler/validate"
+	"github.com/sacloud/go-otelsetup"
+	"github.com/spf13/cobra"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var Command = &cobra.Command{
+	Use:       "lifecycle {launching | terminating} [flags]...",
+	Short:     "Send CompleteLifecycleAction request to Core server to resume the instance waiting on a lifecycle hook",
+	ValidArgs: []string{"launching", "terminating"},
+	Args:      cobra.ExactValidArgs(1),
+	PreRunE: flags.ValidateMultiFunc(true,
+		flags.ValidateDestinationFlags,
+		func(*cobra.Command, []string) error {
+			return validate.Struct(param)
+		},
+	),
+	RunE: run,
+}
+
+type parameter struct {
+	ResourceName string `name:"--resource-name" validate:"required,printascii,max=1024"`
+	ServerID     string `name:"--server-id" validate:"required_without=ServerName,omitempty,numeric"`
+	ServerName   string `name:"--server-name" validate:"required_without=ServerID,omitempty,max=64"`
+	Action       string `name:"--action" validate:"required,oneof=continue abandon"`
+}
+
+var param = &parameter{
+	ResourceName: defaults.ResourceName,
+	Action:       "continue",
+}
+
+func init() {
+	flags.SetDestinationFlag(Command)
+	Command.Flags().StringVarP(&param.ResourceName, "resource-name", "", param.ResourceName, "Name of the target ServerGroup")
+	Command.Flags().StringVarP(&param.ServerID, "server-id", "", param.ServerID, "ID of the server waiting on the lifecycle hook")
+	Command.Flags().StringVarP(&param.ServerName, "server-name", "", param.ServerName, "Name of the server waiting on the lifecycle hook. ignored when --server-id is specified")
+	Command.Flags().StringVarP(&param.Action, "action", "", param.Action, "Lifecycle action. options: [continue/abandon]")
+}
+
+func run(_ *cobra.Command, args []string) error {
+	ctx, span := sacloudotel.Tracer().Start(otelsetup.ContextForTrace(context.Background()), "commands/core/lifecycle#run",
+		trace.WithSpanKind(trace.SpanKindClient),
+	)
+	defer span.End()
+
+	conn, cleanup, err := grpcutil.DialContext(ctx, &grpcutil.DialOption{
+		Destination: flags.Destination(),
+	})
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	client := request.NewScalingServiceClient(conn)
+	if _, err := client.CompleteLifecycleAction(ctx, &request.CompleteLifecycleActionRequest{
+		ResourceName: param.ResourceName,
+		Hook:         args[0],
+		ServerId:     param.ServerID,
+		ServerName:   param.ServerName,
+		Action:       param.Action,
+	}); err != nil {
+		return err
+	}
+
+	fmt.Printf("lifecycle action completed: hook=%s action=%s\n", args[0], param.Action)
+	return nil
+}
@@ -32,9 +32,9 @@ import (
 )
 
 var Command = &cobra.Command{
-	Use:       "direct {up | down | keep} [flags]...",
-	Short:     "Send Up/Down/Keep request directly to Core server",
-	ValidArgs: []string{"up", "down", "keep"},
+	Use:       "direct {up | down | keep | refresh} [flags]...",
+	Short:     "Send Up/Down/Keep/Refresh request directly to Core server",
+	ValidArgs: []string{"up", "down", "keep", "refresh"},
 	Args:      cobra.ExactValidArgs(1),
 	PreRunE: flags.ValidateMultiFunc(true,
 		flags.ValidateDestinationFlags,
@@ -42,6 +42,9 @@ var Command = &cobra.Command{
 			if err := validate.Struct(param); err != nil {
 				return err
 			}
+			if param.DesiredStateName != "" && desiredSpec(cmd) != nil {
+				return fmt.Errorf("--desired-state-name and --desired-* flags cannot be specified at the same time")
+			}
 			return flags.ValidateInputsConfigFlags(cmd, args)
 		},
 	),
@@ -60,6 +63,16 @@ type parameter struct {
 	ResourceName     string `name:"--resource-name" validate:"required,printascii,max=1024"`
 	DesiredStateName string `name:"--desired-state-name" validate:"omitempty,printascii,max=1024"`
 	Sync             bool   `name:"--sync"`
+	Step             uint32 `name:"--step"`
+
+	Labels     map[string]string `name:"--label" validate:"omitempty,dive,keys,printascii,max=256,endkeys,max=4096"`
+	PayloadRef string            `name:"--payload-ref" validate:"omitempty,max=4096"`
+
+	DesiredSize      uint32 `name:"--desired-size"`
+	DesiredCore      uint32 `name:"--desired-core"`
+	DesiredMemory    uint32 `name:"--desired-memory"`
+	DesiredCPS       uint32 `name:"--desired-cps"`
+	DesiredBandWidth uint32 `name:"--desired-band-width"`
 }
 
 var param = &parameter{
@@ -76,9 +89,38 @@ func init() {
 	Command.Flags().StringVarP(&param.Source, "source", "", param.Source, "A string representing the request source, passed to AutoScaler Core")
 	Command.Flags().StringVarP(&param.DesiredStateName, "desired-state-name", "", param.DesiredStateName, "Name of the desired state defined in Core's configuration file")
 	Command.Flags().BoolVarP(&param.Sync, "sync", "", param.Sync, "Flag for synchronous handling")
+	Command.Flags().Uint32VarP(&param.Step, "step", "", param.Step, "Number of plan steps to change at once. ignored when --desired-state-name is specified")
+	Command.Flags().StringToStringVarP(&param.Labels, "label", "", param.Labels, "Labels passed to AutoScaler Core and Handlers. format: key=value")
+	Command.Flags().Uint32VarP(&param.DesiredSize, "desired-size", "", param.DesiredSize, "Desired number of servers for ServerGroup. must be within min_size/max_size")
+	Command.Flags().Uint32VarP(&param.DesiredCore, "desired-core", "", param.DesiredCore, "Desired number of CPU cores for Server. must be used with --desired-memory")
+	Command.Flags().Uint32VarP(&param.DesiredMemory, "desired-memory", "", param.DesiredMemory, "Desired memory size(GiB) for Server. must be used with --desired-core")
+	Command.Flags().Uint32VarP(&param.DesiredCPS, "desired-cps", "", param.DesiredCPS, "Desired CPS for ELB")
+	Command.Flags().Uint32VarP(&param.DesiredBandWidth, "desired-band-width", "", param.DesiredBandWidth, "Desired bandwidth(Mbps) for Router")
+	Command.Flags().StringVarP(&param.PayloadRef, "payload-ref", "", param.PayloadRef, "Reference(e.g. URL) to the payload that triggered the request, passed to AutoScaler Core and Handlers")
+}
+
+// desiredSpec --desired-*フラグからDesiredSpecを組み立てる、いずれのフラグも指定されていない場合はnilを返す
+func desiredSpec(cmd *cobra.Command) *request.DesiredSpec {
+	specified := false
+	for _, name := range []string{"desired-size", "desired-core", "desired-memory", "desired-cps", "desired-band-width"} {
+		if cmd.Flags().Changed(name) {
+			specified = true
+			break
+		}
+	}
+	if !specified {
+		return nil
+	}
+	return &request.DesiredSpec{
+		Size:      param.DesiredSize,
+		Core:      param.DesiredCore,
+		Memory:    param.DesiredMemory,
+		Cps:       param.DesiredCPS,
+		BandWidth: param.DesiredBandWidth,
+	}
 }
 
-func run(_ *cobra.Command, args []string) error {
+func run(cmd *cobra.Command, args []string) error {
 	var exitCode int
 	ctx, span := sacloudotel.Tracer().Start(otelsetup.ContextForTrace(context.Background()), "commands/inputs/direct#run",
 		trace.WithSpanKind(trace.SpanKindClient),
@@ -110,6 +152,8 @@ func run(_ *cobra.Command, args []string) error {
 		f = req.Down
 	case "keep":
 		f = req.Keep
+	case "refresh":
+		f = req.Refresh
 	default:
 		return fmt.Errorf("invalid args: %v", args)
 	}
@@ -118,6 +162,10 @@ func run(_ *cobra.Command, args []string) error {
 		ResourceName:     param.ResourceName,
 		DesiredStateName: param.DesiredStateName,
 		Sync:             param.Sync,
+		Step:             param.Step,
+		Labels:           param.Labels,
+		PayloadRef:       param.PayloadRef,
+		DesiredSpec:      desiredSpec(cmd),
 	})
 	if err != nil {
 		return err
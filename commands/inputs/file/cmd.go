@@ -0,0 +1,69 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/sacloud/autoscaler/commands/flags"
+	"github.com/sacloud/autoscaler/inputs"
+	"github.com/sacloud/autoscaler/inputs/file"
+	"github.com/sacloud/autoscaler/validate"
+	"github.com/spf13/cobra"
+)
+
+var Command = &cobra.Command{
+	Use:   "file",
+	Short: "Watch a file or read newline-delimited JSON from stdin and send requests by threshold rules",
+	PreRunE: flags.ValidateMultiFunc(true,
+		flags.ValidateDestinationFlags,
+		flags.ValidateInputsConfigFlags,
+		func(*cobra.Command, []string) error {
+			return validate.Struct(param)
+		},
+	),
+	RunE: run,
+}
+
+type parameter struct {
+	Path        string `name:"--path" validate:"required_without=Stdin,excluded_with=Stdin"`
+	Stdin       bool   `name:"--stdin"`
+	RulesConfig string `name:"--rules-config" validate:"required,file"`
+}
+
+var param = &parameter{}
+
+func init() {
+	flags.SetDestinationFlag(Command)
+	flags.SetInputsConfigFlag(Command)
+
+	Command.Flags().StringVarP(&param.Path, "path", "", param.Path, "Filepath to watch. The content must be a number or a JSON object")
+	Command.Flags().BoolVarP(&param.Stdin, "stdin", "", param.Stdin, "Read newline-delimited JSON from stdin instead of watching a file")
+	Command.Flags().StringVarP(&param.RulesConfig, "rules-config", "", param.RulesConfig, "Filepath to the configuration file that defines threshold rules")
+}
+
+func run(*cobra.Command, []string) error {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	conf, err := file.LoadConfigFromPath(param.RulesConfig)
+	if err != nil {
+		return err
+	}
+	return inputs.Serve(ctx, file.NewInput(flags.Destination(), flags.InputsConfig(), flags.NewLogger(), param.Path, os.Stdin, conf))
+}
@@ -16,8 +16,16 @@ package inputs
 
 import (
 	"github.com/sacloud/autoscaler/commands/inputs/alertmanager"
+	"github.com/sacloud/autoscaler/commands/inputs/datadog"
 	"github.com/sacloud/autoscaler/commands/inputs/direct"
+	"github.com/sacloud/autoscaler/commands/inputs/file"
+	"github.com/sacloud/autoscaler/commands/inputs/generic"
 	"github.com/sacloud/autoscaler/commands/inputs/grafana"
+	"github.com/sacloud/autoscaler/commands/inputs/mackerel"
+	"github.com/sacloud/autoscaler/commands/inputs/newrelic"
+	"github.com/sacloud/autoscaler/commands/inputs/prometheus"
+	"github.com/sacloud/autoscaler/commands/inputs/sakuracloud"
+	"github.com/sacloud/autoscaler/commands/inputs/simplemonitor"
 	"github.com/sacloud/autoscaler/commands/inputs/webhook"
 	"github.com/sacloud/autoscaler/commands/inputs/zabbix"
 	"github.com/spf13/cobra"
@@ -32,8 +40,16 @@ var Command = &cobra.Command{
 
 var subCommands = []*cobra.Command{
 	alertmanager.Command,
+	datadog.Command,
 	direct.Command,
+	file.Command,
+	generic.Command,
 	grafana.Command,
+	mackerel.Command,
+	newrelic.Command,
+	prometheus.Command,
+	sakuracloud.Command,
+	simplemonitor.Command,
 	webhook.Command,
 	zabbix.Command,
 }
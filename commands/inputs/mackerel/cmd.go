@@ -0,0 +1,72 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mackerel
+
+import (
+	"context"
+	"os/signal"
+	"syscall"
+
+	"github.com/sacloud/autoscaler/commands/flags"
+	"github.com/sacloud/autoscaler/defaults"
+	"github.com/sacloud/autoscaler/inputs"
+	"github.com/sacloud/autoscaler/inputs/mackerel"
+	"github.com/sacloud/autoscaler/validate"
+	"github.com/spf13/cobra"
+)
+
+var Command = &cobra.Command{
+	Use:   "mackerel",
+	Short: "Start web server for handle webhooks from Mackerel",
+	PreRunE: flags.ValidateMultiFunc(true,
+		flags.ValidateDestinationFlags,
+		flags.ValidateListenerFlags,
+		flags.ValidateInputsConfigFlags,
+		func(*cobra.Command, []string) error {
+			return validate.Struct(param)
+		},
+	),
+	RunE: run,
+}
+
+type parameter struct {
+	AcceptAlertStatuses []string `name:"--accept-alert-statuses" validate:"required,dive,oneof=critical warning unknown"`
+	MappingConfig       string   `name:"--mapping-config" validate:"omitempty,file"`
+}
+
+var param = &parameter{
+	AcceptAlertStatuses: []string{"critical", "warning"},
+}
+
+func init() {
+	flags.SetDestinationFlag(Command)
+	flags.SetInputsConfigFlag(Command)
+	flags.SetListenerFlag(Command, defaults.ListenAddress)
+
+	Command.Flags().StringSliceVarP(&param.AcceptAlertStatuses, "accept-alert-statuses", "", param.AcceptAlertStatuses, "List of alert statuses to accept. options: [ critical | warning | unknown ]")
+	Command.Flags().StringVarP(&param.MappingConfig, "mapping-config", "", param.MappingConfig, "Filepath to the configuration file that maps monitor/host names to resource-name and desired-state-name")
+}
+
+func run(*cobra.Command, []string) error {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	mapping, err := mackerel.LoadMappingConfigFromPath(param.MappingConfig)
+	if err != nil {
+		return err
+	}
+	in := mackerel.NewInput(flags.Destination(), flags.ListenAddr(), flags.InputsConfig(), flags.NewLogger(), param.AcceptAlertStatuses, mapping)
+	return inputs.Serve(ctx, in)
+}
@@ -0,0 +1,71 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"os/signal"
+	"syscall"
+
+	"github.com/sacloud/autoscaler/commands/flags"
+	"github.com/sacloud/autoscaler/defaults"
+	"github.com/sacloud/autoscaler/inputs"
+	"github.com/sacloud/autoscaler/inputs/prometheus"
+	"github.com/sacloud/autoscaler/validate"
+	"github.com/spf13/cobra"
+)
+
+var Command = &cobra.Command{
+	Use:   "prometheus",
+	Short: "Start polling Prometheus with PromQL queries and send requests to Core according to the results",
+	PreRunE: flags.ValidateMultiFunc(true,
+		flags.ValidateDestinationFlags,
+		flags.ValidateListenerFlags,
+		flags.ValidateInputsConfigFlags,
+		func(*cobra.Command, []string) error {
+			return validate.Struct(param)
+		},
+	),
+	RunE: run,
+}
+
+type parameter struct {
+	QueryConfig string `name:"--query-config" validate:"required,file"`
+}
+
+var param = &parameter{}
+
+func init() {
+	flags.SetDestinationFlag(Command)
+	flags.SetInputsConfigFlag(Command)
+	flags.SetListenerFlag(Command, defaults.ListenAddress)
+
+	Command.Flags().StringVarP(&param.QueryConfig, "query-config", "", param.QueryConfig, "Filepath to the configuration file that defines Prometheus address, queries and thresholds")
+}
+
+func run(*cobra.Command, []string) error {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	conf, err := prometheus.LoadConfigFromPath(param.QueryConfig)
+	if err != nil {
+		return err
+	}
+	in, err := prometheus.NewInput(flags.Destination(), flags.ListenAddr(), flags.InputsConfig(), flags.NewLogger(), conf)
+	if err != nil {
+		return err
+	}
+	return inputs.Serve(ctx, in)
+}
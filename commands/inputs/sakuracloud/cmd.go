@@ -0,0 +1,79 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"os/signal"
+	"syscall"
+
+	"github.com/sacloud/autoscaler/commands/flags"
+	"github.com/sacloud/autoscaler/core"
+	"github.com/sacloud/autoscaler/defaults"
+	"github.com/sacloud/autoscaler/inputs"
+	"github.com/sacloud/autoscaler/inputs/sakuracloud"
+	"github.com/sacloud/autoscaler/validate"
+	"github.com/spf13/cobra"
+)
+
+var Command = &cobra.Command{
+	Use:   "sakuracloud",
+	Short: "Poll activity metrics of SAKURA Cloud resources and send requests by threshold rules",
+	PreRunE: flags.ValidateMultiFunc(true,
+		flags.ValidateDestinationFlags,
+		flags.ValidateInputsConfigFlags,
+		func(*cobra.Command, []string) error {
+			return validate.Struct(param)
+		},
+	),
+	RunE: run,
+}
+
+type parameter struct {
+	RulesConfig string `name:"--rules-config" validate:"required,file"`
+	CoreConfig  string `name:"--core-config" validate:"required,file"`
+}
+
+var param = &parameter{
+	CoreConfig: defaults.CoreConfigPath,
+}
+
+func init() {
+	flags.SetDestinationFlag(Command)
+	flags.SetInputsConfigFlag(Command)
+
+	Command.Flags().StringVarP(&param.RulesConfig, "rules-config", "", param.RulesConfig, "Filepath to the configuration file that defines target resources, metrics and thresholds")
+	Command.Flags().StringVarP(&param.CoreConfig, "core-config", "", param.CoreConfig, "Filepath to the configuration file of AutoScaler Core, used to find target resources by resource name")
+}
+
+func run(*cobra.Command, []string) error {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	logger := flags.NewLogger()
+	conf, err := sakuracloud.LoadConfigFromPath(param.RulesConfig)
+	if err != nil {
+		return err
+	}
+	coreConf, err := core.NewConfigFromPath(ctx, param.CoreConfig, flags.StrictMode(), logger)
+	if err != nil {
+		return err
+	}
+	sakuraCloud := conf.SakuraCloudConfig(coreConf.SakuraCloud)
+	if err := sakuraCloud.Validate(ctx); err != nil {
+		return err
+	}
+	return inputs.Serve(ctx, sakuracloud.NewInput(flags.Destination(), flags.InputsConfig(), logger, sakuraCloud.APIClient(), conf, coreConf.Resources))
+}
@@ -0,0 +1,75 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simplemonitor
+
+import (
+	"context"
+	"os/signal"
+	"syscall"
+
+	"github.com/sacloud/autoscaler/commands/flags"
+	"github.com/sacloud/autoscaler/defaults"
+	"github.com/sacloud/autoscaler/inputs"
+	"github.com/sacloud/autoscaler/inputs/simplemonitor"
+	"github.com/sacloud/autoscaler/validate"
+	"github.com/spf13/cobra"
+)
+
+var Command = &cobra.Command{
+	Use:   "simplemonitor",
+	Short: "Start web server for handle webhooks from Sakura Cloud Simple Monitor",
+	PreRunE: flags.ValidateMultiFunc(true,
+		flags.ValidateDestinationFlags,
+		flags.ValidateListenerFlags,
+		flags.ValidateInputsConfigFlags,
+		func(*cobra.Command, []string) error {
+			return validate.Struct(param)
+		},
+	),
+	RunE: run,
+}
+
+type parameter struct {
+	DownRequestType string `name:"--down-request-type" validate:"required,oneof=up down keep ignore"`
+	UpRequestType   string `name:"--up-request-type" validate:"required,oneof=up down keep ignore"`
+	MappingConfig   string `name:"--mapping-config" validate:"omitempty,file"`
+}
+
+var param = &parameter{
+	DownRequestType: "up",
+	UpRequestType:   "keep",
+}
+
+func init() {
+	flags.SetDestinationFlag(Command)
+	flags.SetInputsConfigFlag(Command)
+	flags.SetListenerFlag(Command, defaults.ListenAddress)
+
+	Command.Flags().StringVarP(&param.DownRequestType, "down-request-type", "", param.DownRequestType, "Request type to send when the target goes down. options: [ up | down | keep | ignore ]")
+	Command.Flags().StringVarP(&param.UpRequestType, "up-request-type", "", param.UpRequestType, "Request type to send when the target recovers. options: [ up | down | keep | ignore ]")
+	Command.Flags().StringVarP(&param.MappingConfig, "mapping-config", "", param.MappingConfig, "Filepath to the configuration file that maps monitored targets to resource-name and desired-state-name")
+}
+
+func run(*cobra.Command, []string) error {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	mapping, err := simplemonitor.LoadMappingConfigFromPath(param.MappingConfig)
+	if err != nil {
+		return err
+	}
+	in := simplemonitor.NewInput(flags.Destination(), flags.ListenAddr(), flags.InputsConfig(), flags.NewLogger(), param.DownRequestType, param.UpRequestType, mapping)
+	return inputs.Serve(ctx, in)
+}
@@ -19,6 +19,7 @@ import (
 	"net/http"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/sacloud/autoscaler/commands/flags"
 	"github.com/sacloud/autoscaler/defaults"
@@ -45,10 +46,13 @@ var Command = &cobra.Command{
 type parameter struct {
 	AcceptHTTPMethods []string `name:"--accept-http-methods" validate:"required,dive,oneof=GET POST PUT DELETE HEAD"`
 	ExecutablePath    string   `name:"--executable-path" validate:"required,file"`
+	ExecutableTimeout int      `name:"--executable-timeout" validate:"min=0"`
+	DecisionOutput    bool     `name:"--decision-output"`
 }
 
 var param = &parameter{
 	AcceptHTTPMethods: []string{http.MethodPost, http.MethodPut},
+	ExecutableTimeout: 30,
 }
 
 func init() {
@@ -58,12 +62,15 @@ func init() {
 
 	Command.Flags().StringSliceVarP(&param.AcceptHTTPMethods, "accept-http-methods", "", param.AcceptHTTPMethods, "List of HTTP methods to accept")
 	Command.Flags().StringVarP(&param.ExecutablePath, "executable-path", "", param.ExecutablePath, "Path to the executable to determine if webhooks should be accepted")
+	Command.Flags().IntVarP(&param.ExecutableTimeout, "executable-timeout", "", param.ExecutableTimeout, "Timeout in seconds for the executable. 0 means no timeout")
+	Command.Flags().BoolVarP(&param.DecisionOutput, "decision-output", "", param.DecisionOutput, "Use the JSON output of the executable as the decision of the request")
 }
 
 func run(*cobra.Command, []string) error {
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
-	in, err := webhook.NewInput(flags.Destination(), flags.ListenAddr(), flags.InputsConfig(), flags.NewLogger(), param.AcceptHTTPMethods, param.ExecutablePath)
+	in, err := webhook.NewInput(flags.Destination(), flags.ListenAddr(), flags.InputsConfig(), flags.NewLogger(), param.AcceptHTTPMethods, param.ExecutablePath,
+		time.Duration(param.ExecutableTimeout)*time.Second, param.DecisionOutput)
 	if err != nil {
 		return err
 	}
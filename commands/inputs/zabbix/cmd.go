@@ -23,6 +23,7 @@ import (
 	"github.com/sacloud/autoscaler/defaults"
 	"github.com/sacloud/autoscaler/inputs"
 	"github.com/sacloud/autoscaler/inputs/zabbix"
+	"github.com/sacloud/autoscaler/validate"
 	"github.com/spf13/cobra"
 )
 
@@ -33,18 +34,31 @@ var Command = &cobra.Command{
 		flags.ValidateDestinationFlags,
 		flags.ValidateListenerFlags,
 		flags.ValidateInputsConfigFlags,
+		func(*cobra.Command, []string) error {
+			return validate.Struct(param)
+		},
 	),
 	RunE: run,
 }
 
+type parameter struct {
+	MinSeverity int `name:"--min-severity" validate:"min=0,max=5"`
+}
+
+var param = &parameter{
+	MinSeverity: 0,
+}
+
 func init() {
 	flags.SetDestinationFlag(Command)
 	flags.SetInputsConfigFlag(Command)
 	flags.SetListenerFlag(Command, defaults.ListenAddress)
+
+	Command.Flags().IntVarP(&param.MinSeverity, "min-severity", "", param.MinSeverity, "Minimum severity of the event to accept. options: 0(Not classified) - 5(Disaster)")
 }
 
 func run(*cobra.Command, []string) error {
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
-	return inputs.Serve(ctx, zabbix.NewInput(flags.Destination(), flags.ListenAddr(), flags.InputsConfig(), flags.NewLogger()))
+	return inputs.Serve(ctx, zabbix.NewInput(flags.Destination(), flags.ListenAddr(), flags.InputsConfig(), flags.NewLogger(), param.MinSeverity))
 }
@@ -0,0 +1,270 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/sacloud/autoscaler/handler"
+	"github.com/sacloud/autoscaler/validate"
+	"github.com/sacloud/iaas-api-go"
+)
+
+// Adoption 既存サーバをServerGroupに取り込む(adopt)際の設定
+//
+// Adoptリクエストを受け取るとSelectorに一致するサーバのうちテンプレートと一致するものを
+// グループの命名規則に沿ってリネームし、タグを付与した上で親リソースにアタッチする。
+// サーバ名がグループ内でのインデックスを表すため、リネームしたサーバは以降は通常のグループのサーバとして扱われる
+type Adoption struct {
+	// Selector 取り込み対象のサーバを選択するためのセレクタ、ServerGroupのゾーン内で検索される
+	Selector *ResourceSelector `yaml:"selector" validate:"required"`
+}
+
+func (a *Adoption) Validate() []error {
+	if errs := validate.StructWithMultiError(a); len(errs) > 0 {
+		return errs
+	}
+	if err := a.Selector.Validate(); err != nil {
+		return []error{validate.Errorf("adoption.selector: %s", err)}
+	}
+	return nil
+}
+
+// adoptionIgnoredFields 取り込み時にテンプレートの値で上書きされるため、テンプレートとの一致の判定から除外する項目
+var adoptionIgnoredFields = []string{"description", "tags"}
+
+// AdoptionCandidate 取り込み対象として選択されたサーバ
+type AdoptionCandidate struct {
+	ResourceName string
+	ServerID     string
+	Zone         string
+	CurrentName  string
+	NewName      string // 取り込み後のサーバ名、取り込めない場合は空
+	Index        int    // 取り込み後のグループ内でのインデックス
+	Reason       string // 取り込めない場合の理由、取り込み可能な場合は空
+
+	server *iaas.Server
+}
+
+// Adoptable 取り込み可能な場合true
+func (c *AdoptionCandidate) Adoptable() bool {
+	return c.Reason == ""
+}
+
+// adoptionCandidates Adoption.Selectorに一致するサーバを検索し、それぞれに割り当てるインデックスを決定する
+//
+// managedはグループで管理済みのサーバ(ウォームプールを含む)、これらは取り込み対象から除外される。
+// 各サーバにはテンプレートと一致する空きインデックスのうち最も小さいものを割り当てる。
+// assign_cidr_blockを指定している場合はサーバのIPアドレスと一致するインデックスが割り当てられる
+func (d *ResourceDefServerGroup) adoptionCandidates(ctx *RequestContext, apiClient iaas.APICaller, managed []*iaas.Server) ([]*AdoptionCandidate, error) {
+	if d.Adoption == nil {
+		return nil, nil
+	}
+
+	serverOp := iaas.NewServerOp(apiClient)
+	var servers []*iaas.Server
+	for _, zone := range d.Zones {
+		found, err := serverOp.Find(ctx, zone, d.Adoption.Selector.findCondition())
+		if err != nil {
+			return nil, err
+		}
+		for _, server := range found.Servers {
+			if slices.ContainsFunc(managed, func(s *iaas.Server) bool { return s.ID == server.ID }) {
+				continue
+			}
+			servers = append(servers, server)
+		}
+	}
+	sort.Slice(servers, func(i, j int) bool {
+		return servers[i].Name < servers[j].Name
+	})
+
+	used := make([]bool, d.MaxSize)
+	for i := range used {
+		used[i] = d.findCloudResourceByName(managed, d.serverNameByIndex(i)) != nil
+	}
+
+	var candidates []*AdoptionCandidate
+	for _, server := range servers {
+		candidate := &AdoptionCandidate{
+			ResourceName: d.Name(),
+			ServerID:     server.ID.String(),
+			Zone:         server.Zone.Name,
+			CurrentName:  server.Name,
+			server:       server,
+		}
+		var mismatch []*FieldDrift
+		for i := range used {
+			if used[i] {
+				continue
+			}
+			fields, err := d.adoptionDiff(ctx.WithZone(candidate.Zone), apiClient, server, i)
+			if err != nil {
+				return nil, err
+			}
+			if len(fields) == 0 {
+				used[i] = true
+				candidate.Index = i
+				candidate.NewName = d.serverNameByIndex(i)
+				break
+			}
+			if mismatch == nil {
+				mismatch = fields
+			}
+		}
+
+		if candidate.NewName == "" {
+			if mismatch == nil {
+				candidate.Reason = fmt.Sprintf("no free index: max_size(%d) reached", d.MaxSize)
+			} else {
+				var reasons []string
+				for _, f := range mismatch {
+					reasons = append(reasons, f.String())
+				}
+				candidate.Reason = "does not match the template: " + strings.Join(reasons, ", ")
+			}
+		}
+		candidates = append(candidates, candidate)
+	}
+	return candidates, nil
+}
+
+// adoptionDiff サーバをindexのサーバとして取り込む場合のテンプレートとの差分を返す
+func (d *ResourceDefServerGroup) adoptionDiff(ctx *RequestContext, apiClient iaas.APICaller, server *iaas.Server, index int) ([]*FieldDrift, error) {
+	resource := d.createResourceFromServer(apiClient, nil, server)
+	resource.indexInGroup = index
+
+	fields, err := d.diffInstance(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(fields, func(f *FieldDrift) bool {
+		return slices.Contains(adoptionIgnoredFields, f.Field)
+	}), nil
+}
+
+// buildInstancesForAdoption 取り込み可能なサーバをリネームして親リソースにアタッチするためのリソースを返す
+//
+// ウォームプールのサーバと同様に、作成指示(CREATE)としつつ既存サーバのIDを持たせることでハンドラーに既存サーバの利用を示す
+func (d *ResourceDefServerGroup) buildInstancesForAdoption(ctx *RequestContext, apiClient iaas.APICaller, managed []*iaas.Server) (Resources, error) {
+	candidates, err := d.adoptionCandidates(ctx, apiClient, managed)
+	if err != nil {
+		return nil, err
+	}
+
+	var parent Resource
+	var iconId string
+	var resources Resources
+	for _, candidate := range candidates {
+		if !candidate.Adoptable() {
+			ctx.Logger().Warn("server cannot be adopted",
+				slog.String("server", candidate.CurrentName),
+				slog.String("id", candidate.ServerID),
+				slog.String("zone", candidate.Zone),
+				slog.String("reason", candidate.Reason),
+			)
+			continue
+		}
+
+		if len(resources) == 0 {
+			parent, err = d.computeParent(ctx, apiClient)
+			if err != nil {
+				return nil, err
+			}
+			iconId, err = d.Template.FindIconId(ctx, apiClient)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		resource, err := d.createResourceFromPool(ctx, apiClient, parent, iconId, candidate.server, candidate.NewName, candidate.Index)
+		if err != nil {
+			return nil, err
+		}
+		d.applyAdoptedPlan(resource, candidate.server)
+
+		ctx.Logger().Info("adopting server",
+			slog.String("server", candidate.CurrentName),
+			slog.String("id", candidate.ServerID),
+			slog.String("zone", candidate.Zone),
+			slog.String("new-name", candidate.NewName),
+		)
+		resources = append(resources, resource)
+	}
+	return resources, nil
+}
+
+// applyAdoptedPlan 取り込むサーバの現在のプランをリソースに反映する
+//
+// 取り込み時にプランは変更しないため、代替プランを示すタグも現在のプランに合わせる
+func (d *ResourceDefServerGroup) applyAdoptedPlan(resource *ResourceServerGroupInstance, server *iaas.Server) {
+	resource.server.CPU = server.CPU
+	resource.server.MemoryMB = server.MemoryMB
+	resource.server.GPU = server.GPU
+	resource.server.CPUModel = server.CPUModel
+	resource.server.Commitment = server.Commitment
+	resource.fallbackPlans = nil
+
+	if !d.Template.hasFallbackPlans() {
+		return
+	}
+	plans := d.Template.plansForZone(resource.zone)
+	i := slices.IndexFunc(plans, func(p *ServerGroupInstancePlan) bool { return p.equals(server) })
+	if i < 0 {
+		return
+	}
+	tags := slices.DeleteFunc(slices.Clone(resource.server.Tags), func(tag string) bool {
+		return strings.HasPrefix(tag, handler.ServerPlanTagPrefix)
+	})
+	resource.server.Tags = append(tags, plans[i].tag())
+}
+
+// Adopt 既存サーバをServerGroupに取り込む
+//
+// 対象はadoptionが設定されたServerGroupのみ。
+// dryRunがtrueの場合は取り込み内容の算出のみを行い、ジョブは起動しない(JobStatusはnilとなる)
+func (c *Core) Adopt(ctx *RequestContext, dryRun bool) (*JobStatus, []*AdoptionCandidate, string, error) {
+	rds, err := c.targetResourceDef(ctx)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	var candidates []*AdoptionCandidate
+	for _, def := range rds {
+		sg, ok := def.(*ResourceDefServerGroup)
+		if !ok || sg.Adoption == nil {
+			return nil, nil, "", fmt.Errorf("resource %q does not support adoption: adoption must be configured on ServerGroup", def.Name())
+		}
+		managed, err := sg.findAllCloudResources(ctx, c.config.APIClient())
+		if err != nil {
+			return nil, nil, "", err
+		}
+		found, err := sg.adoptionCandidates(ctx, c.config.APIClient(), managed)
+		if err != nil {
+			return nil, nil, "", err
+		}
+		candidates = append(candidates, found...)
+	}
+	if dryRun {
+		return nil, candidates, "", nil
+	}
+
+	job, message, err := c.handle(ctx)
+	return job, candidates, message, err
+}
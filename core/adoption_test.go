@@ -0,0 +1,132 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sacloud/autoscaler/handler"
+	"github.com/sacloud/autoscaler/test"
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/iaas-api-go/types"
+	"github.com/sacloud/packages-go/size"
+	"github.com/stretchr/testify/require"
+)
+
+func testAdoptionServer(t *testing.T, name string, core, memory int, tags ...string) *iaas.Server {
+	server, err := iaas.NewServerOp(test.APIClient).Create(context.Background(), test.Zone, &iaas.ServerCreateRequest{
+		CPU:             core,
+		MemoryMB:        memory * size.GiB,
+		Commitment:      types.Commitments.Standard,
+		Generation:      types.PlanGenerations.Default,
+		InterfaceDriver: types.InterfaceDrivers.VirtIO,
+		Name:            name,
+		Tags:            tags,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		iaas.NewServerOp(test.APIClient).Delete(context.Background(), test.Zone, server.ID) //nolint:errcheck
+	})
+	return server
+}
+
+func testAdoptionDef(maxSize int, tag string) *ResourceDefServerGroup {
+	return &ResourceDefServerGroup{
+		ResourceDefBase: &ResourceDefBase{
+			TypeName: ResourceTypeServerGroup.String(),
+			DefName:  "adoption",
+		},
+		Zones:   []string{test.Zone},
+		MinSize: 0,
+		MaxSize: maxSize,
+		Template: &ServerGroupInstanceTemplate{
+			Tags: []string{"group"},
+			Plan: &ServerGroupInstancePlan{Core: 2, Memory: 4},
+		},
+		Adoption: &Adoption{
+			Selector: &ResourceSelector{Tags: []string{tag}},
+		},
+	}
+}
+
+func TestAdoption_Validate(t *testing.T) {
+	require.Len(t, (&Adoption{}).Validate(), 1)
+	require.NotEmpty(t, (&Adoption{Selector: &ResourceSelector{}}).Validate())
+	require.Empty(t, (&Adoption{Selector: &ResourceSelector{Tags: []string{"adopt"}}}).Validate())
+}
+
+func TestResourceDefServerGroup_adoptionCandidates(t *testing.T) {
+	ctx := testContext()
+	managed := testAdoptionServer(t, "adoption-001", 2, 4)
+	web1 := testAdoptionServer(t, "web1", 2, 4, "adopt-candidates")
+	web2 := testAdoptionServer(t, "web2", 2, 4, "adopt-candidates")
+	large := testAdoptionServer(t, "large", 4, 8, "adopt-candidates")
+
+	t.Run("assigns free indexes", func(t *testing.T) {
+		d := testAdoptionDef(3, "adopt-candidates")
+		got, err := d.adoptionCandidates(ctx, test.APIClient, []*iaas.Server{managed})
+		require.NoError(t, err)
+		require.Len(t, got, 3)
+
+		// 名前の昇順
+		require.Equal(t, large.ID.String(), got[0].ServerID)
+		require.False(t, got[0].Adoptable())
+		require.Contains(t, got[0].Reason, "plan.core")
+
+		require.Equal(t, web1.ID.String(), got[1].ServerID)
+		require.Equal(t, "adoption-002", got[1].NewName)
+		require.Equal(t, 1, got[1].Index)
+
+		require.Equal(t, web2.ID.String(), got[2].ServerID)
+		require.Equal(t, "adoption-003", got[2].NewName)
+		require.Equal(t, 2, got[2].Index)
+	})
+
+	t.Run("max_size reached", func(t *testing.T) {
+		d := testAdoptionDef(2, "adopt-candidates")
+		got, err := d.adoptionCandidates(ctx, test.APIClient, []*iaas.Server{managed})
+		require.NoError(t, err)
+		require.Len(t, got, 3)
+		require.Equal(t, "adoption-002", got[1].NewName)
+		require.False(t, got[2].Adoptable())
+		require.Contains(t, got[2].Reason, "max_size")
+	})
+
+	t.Run("managed servers are excluded", func(t *testing.T) {
+		d := testAdoptionDef(3, "adopt-candidates")
+		got, err := d.adoptionCandidates(ctx, test.APIClient, []*iaas.Server{managed, web1, web2, large})
+		require.NoError(t, err)
+		require.Empty(t, got)
+	})
+}
+
+func TestResourceDefServerGroup_buildInstancesForAdoption(t *testing.T) {
+	ctx := NewRequestContext(context.Background(), &requestInfo{requestType: requestTypeAdopt}, test.Logger)
+	server := testAdoptionServer(t, "app1", 2, 4, "adopt-build", "app")
+	testAdoptionServer(t, "app2", 4, 8, "adopt-build")
+
+	d := testAdoptionDef(2, "adopt-build")
+	resources, err := d.Compute(ctx, test.APIClient)
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+
+	instance := resources[0].(*ResourceServerGroupInstance)
+	require.Equal(t, handler.ResourceInstructions_CREATE, instance.instruction)
+	require.Equal(t, server.ID, instance.server.ID)
+	require.Equal(t, "adoption-001", instance.server.Name)
+	require.Equal(t, types.Tags{"group"}, instance.server.Tags)
+	require.Equal(t, 0, instance.indexInGroup)
+}
@@ -23,9 +23,10 @@ type computedELB struct {
 	instruction      handler.ResourceInstructions
 	setupGracePeriod int
 
-	elb    *iaas.ProxyLB
-	newCPS int
-	parent Computed // 親リソースのComputed
+	elb          *iaas.ProxyLB
+	newCPS       int
+	drainTimeout int      // 配下のサーバを切り離す際のドレインの待ち時間(秒)
+	parent       Computed // 親リソースのComputed
 }
 
 func (c *computedELB) ID() string {
@@ -73,6 +74,7 @@ func (c *computedELB) Current() *handler.Resource {
 					Plan:             uint32(c.elb.Plan.Int()),
 					VirtualIpAddress: c.elb.VirtualIPAddress,
 					Fqdn:             c.elb.FQDN,
+					DrainTimeout:     uint32(c.drainTimeout),
 					Parent:           c.parents(),
 				},
 			},
@@ -92,6 +94,7 @@ func (c *computedELB) Desired() *handler.Resource {
 					Plan:             uint32(c.newCPS),
 					VirtualIpAddress: c.elb.VirtualIPAddress,
 					Fqdn:             c.elb.FQDN,
+					DrainTimeout:     uint32(c.drainTimeout),
 					Parent:           c.parents(),
 				},
 			},
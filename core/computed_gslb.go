@@ -23,7 +23,8 @@ type computedGSLB struct {
 	instruction      handler.ResourceInstructions
 	setupGracePeriod int
 
-	gslb *iaas.GSLB
+	gslb         *iaas.GSLB
+	drainTimeout int // 配下のサーバを切り離す際のドレインの待ち時間(秒)
 }
 
 func (c *computedGSLB) ID() string {
@@ -69,10 +70,11 @@ func (c *computedGSLB) Current() *handler.Resource {
 		return &handler.Resource{
 			Resource: &handler.Resource_Gslb{
 				Gslb: &handler.GSLB{
-					Id:      c.gslb.ID.String(),
-					Name:    c.gslb.Name,
-					Fqdn:    c.gslb.FQDN,
-					Servers: servers,
+					Id:           c.gslb.ID.String(),
+					Name:         c.gslb.Name,
+					Fqdn:         c.gslb.FQDN,
+					Servers:      servers,
+					DrainTimeout: uint32(c.drainTimeout),
 				},
 			},
 		}
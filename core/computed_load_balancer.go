@@ -23,8 +23,9 @@ type computedLoadBalancer struct {
 	instruction      handler.ResourceInstructions
 	setupGracePeriod int
 
-	lb   *iaas.LoadBalancer
-	zone string
+	lb           *iaas.LoadBalancer
+	zone         string
+	drainTimeout int // 配下のサーバを切り離す際のドレインの待ち時間(秒)
 }
 
 func (c *computedLoadBalancer) ID() string {
@@ -81,6 +82,7 @@ func (c *computedLoadBalancer) Current() *handler.Resource {
 					Name:               c.lb.Name,
 					Zone:               c.zone,
 					VirtualIpAddresses: vip,
+					DrainTimeout:       uint32(c.drainTimeout),
 				},
 			},
 		}
@@ -35,6 +35,8 @@ type computedServerGroupInstance struct {
 
 	parent        Computed // 親Resourceのcomputed
 	shutdownForce bool
+	pooled        bool
+	fallbackPlans []*handler.ServerGroupInstance_Plan
 }
 
 func (c *computedServerGroupInstance) ID() string {
@@ -106,6 +108,8 @@ func (c *computedServerGroupInstance) computeResource() *handler.Resource {
 					Description:       c.server.Description,
 					IconId:            c.server.IconID.String(),
 					ShutdownForce:     c.shutdownForce,
+					Pooled:            c.pooled,
+					FallbackPlans:     c.fallbackPlans,
 				},
 			},
 		}
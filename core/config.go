@@ -75,7 +75,7 @@ func (c *Config) load(ctx context.Context, reader io.Reader) error {
 	if c.SakuraCloud == nil {
 		c.SakuraCloud = &SakuraCloud{}
 	}
-	c.SakuraCloud.strictMode = c.strictMode
+	c.SakuraCloud.SetStrictMode(c.strictMode)
 	return nil
 }
 
@@ -135,6 +135,12 @@ func TestConfig_Validate(t *testing.T) {
 		},
 	}
 
+	sakuraCloud := func(strictMode bool, profile string) *SakuraCloud {
+		sc := &SakuraCloud{Profile: profile}
+		sc.SetStrictMode(strictMode)
+		return sc
+	}
+
 	type fields struct {
 		SakuraCloud    *SakuraCloud
 		CustomHandlers Handlers
@@ -150,7 +156,7 @@ func TestConfig_Validate(t *testing.T) {
 		{
 			name: "minimum",
 			fields: fields{
-				SakuraCloud: &SakuraCloud{strictMode: false},
+				SakuraCloud: sakuraCloud(false, ""),
 				Resources:   resources,
 			},
 			wantErr: false,
@@ -159,7 +165,7 @@ func TestConfig_Validate(t *testing.T) {
 			name: "strict with sakuracloud.profile",
 			fields: fields{
 				strictMode:  true,
-				SakuraCloud: &SakuraCloud{strictMode: true, Profile: "foobar"},
+				SakuraCloud: sakuraCloud(true, "foobar"),
 				Resources:   resources,
 			},
 			wantErr: true,
@@ -168,7 +174,7 @@ func TestConfig_Validate(t *testing.T) {
 			name: "strict with exporter",
 			fields: fields{
 				strictMode:  true,
-				SakuraCloud: &SakuraCloud{strictMode: true},
+				SakuraCloud: sakuraCloud(true, ""),
 				Resources:   resources,
 				AutoScaler: AutoScalerConfig{
 					ExporterConfig: &config.ExporterConfig{
@@ -183,7 +189,7 @@ func TestConfig_Validate(t *testing.T) {
 			name: "strict with custom handlers",
 			fields: fields{
 				strictMode:  true,
-				SakuraCloud: &SakuraCloud{strictMode: true},
+				SakuraCloud: sakuraCloud(true, ""),
 				Resources:   resources,
 				CustomHandlers: Handlers{
 					{
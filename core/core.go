@@ -45,6 +45,9 @@ type Core struct {
 	running     bool
 	stopping    bool
 	shutdownErr error
+
+	activityMu     sync.Mutex
+	lastActivities map[string]time.Time // リソースごとの最終アクティビティ日時、アイドルタイムアウトの判定に利用する
 }
 
 func newCoreInstance(addr string, c *Config, logger *slog.Logger) (*Core, error) {
@@ -56,6 +59,8 @@ func newCoreInstance(addr string, c *Config, logger *slog.Logger) (*Core, error)
 		config:        c,
 		jobs:          make(map[string]*JobStatus),
 		logger:        logger,
+
+		lastActivities: make(map[string]time.Time),
 	}, nil
 }
 
@@ -162,6 +167,12 @@ func (c *Core) run(ctx context.Context) error {
 		}()
 	}
 
+	// idle timeout
+	go c.runIdleTimeout(ctx)
+
+	// drift detection
+	c.runDriftDetection(ctx)
+
 	go func() {
 		c.logger.Info("started", slog.String("address", listener.Addr().String()))
 		if err := server.Serve(listener); err != nil {
@@ -179,6 +190,7 @@ func (c *Core) run(ctx context.Context) error {
 }
 
 func (c *Core) Up(ctx *RequestContext) (*JobStatus, string, error) {
+	c.recordActivity(ctx.Request().resourceName, time.Now())
 	return c.handle(ctx)
 }
 
@@ -187,6 +199,24 @@ func (c *Core) Down(ctx *RequestContext) (*JobStatus, string, error) {
 }
 
 func (c *Core) Keep(ctx *RequestContext) (*JobStatus, string, error) {
+	c.recordActivity(ctx.Request().resourceName, time.Now())
+	return c.handle(ctx)
+}
+
+// Refresh ServerGroupのインスタンスリフレッシュを行う
+//
+// 対象はinstance_refreshが有効なServerGroupのみ
+func (c *Core) Refresh(ctx *RequestContext) (*JobStatus, string, error) {
+	rds, err := c.targetResourceDef(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, def := range rds {
+		sg, ok := def.(*ResourceDefServerGroup)
+		if !ok || !sg.InstanceRefresh.enabled() {
+			return nil, "", fmt.Errorf("resource %q does not support instance refresh: instance_refresh must be enabled on ServerGroup", def.Name())
+		}
+	}
 	return c.handle(ctx)
 }
 
@@ -249,10 +279,10 @@ func (c *Core) handle(ctx *RequestContext) (*JobStatus, string, error) {
 		return job, "job is in an unacceptable state", nil
 	}
 
-	job.SetStatus(request.ScalingJobStatus_JOB_ACCEPTED)
+	job.Accept(ctx.Request())
 	ctx.Logger().Info(
 		"request has been accepted",
-		slog.String("status", request.ScalingJobStatus_JOB_ACCEPTED.String()),
+		append([]any{slog.String("status", request.ScalingJobStatus_JOB_ACCEPTED.String())}, ctx.Request().metadataAttrs()...)...,
 	)
 
 	c.setRunningStatus(true)
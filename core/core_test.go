@@ -132,6 +132,106 @@ func TestCore_ResourceName(t *testing.T) {
 	}
 }
 
+func TestCore_unsupportedRequests(t *testing.T) {
+	c := &Core{
+		listenAddress: defaults.CoreSocketAddr,
+		config: &Config{
+			Resources: ResourceDefinitions{
+				&stubResourceDef{
+					ResourceDefBase: &ResourceDefBase{TypeName: "stub", DefName: "name1"},
+				},
+				&ResourceDefServerGroup{
+					ResourceDefBase: &ResourceDefBase{TypeName: "ServerGroup", DefName: "name2"},
+				},
+			},
+		},
+		jobs: make(map[string]*JobStatus),
+	}
+
+	refresh := func(ctx *RequestContext) error {
+		_, _, err := c.Refresh(ctx)
+		return err
+	}
+	adopt := func(ctx *RequestContext) error {
+		_, _, _, err := c.Adopt(ctx, true)
+		return err
+	}
+
+	tests := []struct {
+		name         string
+		requestType  RequestTypes
+		resourceName string
+		handle       func(ctx *RequestContext) error
+		wantErr      string
+	}{
+		{
+			name:         "refresh: not a ServerGroup",
+			requestType:  requestTypeRefresh,
+			resourceName: "name1",
+			handle:       refresh,
+			wantErr:      "does not support instance refresh",
+		},
+		{
+			name:         "refresh: instance_refresh is not enabled",
+			requestType:  requestTypeRefresh,
+			resourceName: "name2",
+			handle:       refresh,
+			wantErr:      "does not support instance refresh",
+		},
+		{
+			name:         "adopt: not a ServerGroup",
+			requestType:  requestTypeAdopt,
+			resourceName: "name1",
+			handle:       adopt,
+			wantErr:      "does not support adoption",
+		},
+		{
+			name:         "adopt: adoption is not configured",
+			requestType:  requestTypeAdopt,
+			resourceName: "name2",
+			handle:       adopt,
+			wantErr:      "does not support adoption",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := NewRequestContext(context.Background(), &requestInfo{
+				requestType:  tt.requestType,
+				resourceName: tt.resourceName,
+			}, test.Logger)
+			err := tt.handle(ctx)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestCore_Down_recordsRequest(t *testing.T) {
+	c := &Core{
+		config: &Config{
+			SakuraCloud: &SakuraCloud{},
+			Resources: ResourceDefinitions{
+				&stubResourceDef{
+					ResourceDefBase: &ResourceDefBase{TypeName: "stub", DefName: "name1"},
+				},
+			},
+		},
+		jobs: make(map[string]*JobStatus),
+	}
+
+	ctx := NewRequestContext(context.Background(), &requestInfo{
+		requestType:  requestTypeDown,
+		resourceName: "name1",
+		sync:         true,
+		labels:       map[string]string{"alertname": "HighCPU"},
+		payloadRef:   "https://alertmanager.example.com/#/alerts",
+	}, test.Logger)
+	job, _, err := c.Down(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"alertname": "HighCPU"}, job.Labels())
+	require.Equal(t, "https://alertmanager.example.com/#/alerts", job.PayloadRef())
+}
+
 func TestLoadAndValidate(t *testing.T) {
 	os.Setenv("SAKURACLOUD_FAKE_MODE", "1") //nolint:errcheck
 	defer test.AddTestELB(t, "example")()
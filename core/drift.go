@@ -0,0 +1,290 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sacloud/autoscaler/defaults"
+	"github.com/sacloud/autoscaler/handler"
+	"github.com/sacloud/autoscaler/metrics"
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/iaas-api-go/types"
+)
+
+// DriftDetection ServerGroupのドリフト(テンプレートとサーバの実際の状態との差分)の定期検出の設定
+//
+// 検出結果はログとメトリクス(sacloud_autoscaler_server_group_drifted_instances)に出力される
+type DriftDetection struct {
+	Enabled  bool `yaml:"enabled"`
+	Interval int  `yaml:"interval" validate:"omitempty,min=60"` // 秒数、省略時は10分
+}
+
+func (d *DriftDetection) enabled() bool {
+	return d != nil && d.Enabled
+}
+
+func (d *DriftDetection) Duration() time.Duration {
+	if d.Interval <= 0 {
+		return defaults.DriftDetectionInterval
+	}
+	return time.Duration(d.Interval) * time.Second
+}
+
+// driftDetectionSourceName 定期的なドリフト検出を行う際のSource
+const driftDetectionSourceName = "drift-detection"
+
+// InstanceDrift テンプレートと一致しないServerGroupのサーバ
+type InstanceDrift struct {
+	ResourceName string
+	ServerID     string
+	ServerName   string
+	Zone         string
+	Fields       []*FieldDrift
+}
+
+// FieldDrift 項目ごとのテンプレートから算出した値(Expected)と実際の値(Actual)
+type FieldDrift struct {
+	Field    string
+	Expected string
+	Actual   string
+}
+
+func (f *FieldDrift) String() string {
+	return fmt.Sprintf("%s: expected=%q actual=%q", f.Field, f.Expected, f.Actual)
+}
+
+// driftIgnoredTags オートスケーラーが管理のために付与するタグ、ドリフトの判定からは除外する
+var driftIgnoredTags = []string{TemplateFingerprintTagPrefix, ScaleInProtectionTag, handler.ServerPlanTagPrefix}
+
+// DetectDrift サーバグループ内の各サーバとテンプレートを比較し、差分のあるサーバを返す
+//
+// 参照のみを行い、サーバへの変更は行わない
+func (d *ResourceDefServerGroup) DetectDrift(ctx *RequestContext, apiClient iaas.APICaller) ([]*InstanceDrift, error) {
+	cloudResources, err := d.findCloudResources(ctx, apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*InstanceDrift
+	for _, server := range cloudResources {
+		resource := d.createResourceFromServer(apiClient, nil, server)
+		resource.indexInGroup = d.resourceIndex(resource)
+
+		fields, err := d.diffInstance(ctx.WithZone(resource.zone), resource)
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		results = append(results, &InstanceDrift{
+			ResourceName: d.Name(),
+			ServerID:     server.ID.String(),
+			ServerName:   server.Name,
+			Zone:         resource.zone,
+			Fields:       fields,
+		})
+	}
+	return results, nil
+}
+
+// diffInstance サーバとテンプレートを項目ごとに比較し、差分を返す
+func (d *ResourceDefServerGroup) diffInstance(ctx *RequestContext, resource *ResourceServerGroupInstance) ([]*FieldDrift, error) {
+	server := resource.server
+	// 代替のプランで作成されたサーバは、そのプランと比較する
+	plans := d.Template.plansForZone(resource.zone)
+	plan := plans[0]
+	if i := slices.IndexFunc(plans, func(p *ServerGroupInstancePlan) bool { return p.equals(server) }); i >= 0 {
+		plan = plans[i]
+	}
+
+	var fields []*FieldDrift
+	add := func(field, expected, actual string) {
+		if expected != actual {
+			fields = append(fields, &FieldDrift{Field: field, Expected: expected, Actual: actual})
+		}
+	}
+
+	add("plan.core", strconv.Itoa(plan.Core), strconv.Itoa(server.CPU))
+	add("plan.memory", strconv.Itoa(plan.Memory), strconv.Itoa(server.GetMemoryGB()))
+	add("plan.gpu", strconv.Itoa(plan.GPU), strconv.Itoa(server.GPU))
+	if plan.CPUModel != "" {
+		add("plan.cpu_model", plan.CPUModel, server.CPUModel)
+	}
+	add("plan.dedicated_cpu", strconv.FormatBool(plan.DedicatedCPU), strconv.FormatBool(server.Commitment.IsDedicatedCPU()))
+
+	add("description", d.Template.Description, server.Description)
+	add("tags",
+		strings.Join(driftComparableTags(d.Template.CalculateTagsByIndex(resource.indexInGroup, len(d.Zones))), ","),
+		strings.Join(driftComparableTags(server.Tags), ","),
+	)
+
+	interfaceDriver := d.Template.InterfaceDriver
+	if interfaceDriver == "" {
+		interfaceDriver = types.InterfaceDrivers.VirtIO
+	}
+	add("interface_driver", interfaceDriver.String(), server.InterfaceDriver.String())
+
+	// テンプレートから算出したNICは新規作成時と同じ方法で求める
+	expected := &ResourceServerGroupInstance{
+		apiClient:    resource.apiClient,
+		server:       server,
+		zone:         resource.zone,
+		def:          d,
+		instruction:  handler.ResourceInstructions_CREATE,
+		indexInGroup: resource.indexInGroup,
+	}
+	expectedNICs, err := expected.computeNetworkInterfaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	actualNICs, err := resource.computeNetworkInterfaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(expectedNICs) != len(actualNICs) {
+		add("network_interfaces", fmt.Sprintf("%d interfaces", len(expectedNICs)), fmt.Sprintf("%d interfaces", len(actualNICs)))
+		return fields, nil
+	}
+	for i := range expectedNICs {
+		prefix := fmt.Sprintf("network_interfaces[%d]", i)
+		add(prefix+".upstream", expectedNICs[i].Upstream, actualNICs[i].Upstream)
+		add(prefix+".packet_filter_id", expectedNICs[i].PacketFilterId, actualNICs[i].PacketFilterId)
+		if expectedNICs[i].Upstream != "shared" {
+			add(prefix+".user_ip_address", expectedNICs[i].UserIpAddress, actualNICs[i].UserIpAddress)
+		}
+	}
+	return fields, nil
+}
+
+// driftComparableTags ドリフトの判定用にオートスケーラーが管理するタグを除外し、ソートしたタグを返す
+func driftComparableTags(tags []string) []string {
+	var results []string
+	for _, tag := range tags {
+		if slices.ContainsFunc(driftIgnoredTags, func(ignored string) bool { return strings.HasPrefix(tag, ignored) }) {
+			continue
+		}
+		results = append(results, tag)
+	}
+	slices.Sort(results)
+	return results
+}
+
+// DetectDrift 指定したServerGroup(空の場合は全てのServerGroup)のドリフトを検出する
+func (c *Core) DetectDrift(ctx *RequestContext, resourceName string) ([]*InstanceDrift, error) {
+	found := false
+	var results []*InstanceDrift
+	for _, def := range c.config.Resources {
+		sg, ok := def.(*ResourceDefServerGroup)
+		if !ok || (resourceName != "" && sg.Name() != resourceName) {
+			continue
+		}
+		found = true
+
+		drifts, err := sg.DetectDrift(ctx, c.config.APIClient())
+		if err != nil {
+			return nil, err
+		}
+		metrics.SetDriftedInstances(sg.Name(), len(drifts))
+		results = append(results, drifts...)
+	}
+	if resourceName != "" && !found {
+		return nil, fmt.Errorf("ServerGroup %q not found", resourceName)
+	}
+	return results, nil
+}
+
+// runDriftDetection ドリフトの定期検出が有効なServerGroupごとに指定間隔で検出を行う
+func (c *Core) runDriftDetection(ctx context.Context) {
+	for _, def := range c.config.Resources {
+		sg, ok := def.(*ResourceDefServerGroup)
+		if !ok || !sg.DriftDetection.enabled() {
+			continue
+		}
+		go func() {
+			ticker := time.NewTicker(sg.DriftDetection.Duration())
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					c.reportDrift(sg)
+				}
+			}
+		}()
+	}
+}
+
+func (c *Core) reportDrift(sg *ResourceDefServerGroup) {
+	ctx := NewRequestContext(context.Background(), &requestInfo{
+		requestType:  requestTypeUnknown,
+		source:       driftDetectionSourceName,
+		resourceName: sg.Name(),
+	}, c.logger)
+
+	drifts, err := c.DetectDrift(ctx, sg.Name())
+	if err != nil {
+		ctx.Logger().Error("drift detection failed", slog.Any("error", err))
+		return
+	}
+	for _, drift := range drifts {
+		var fields []string
+		for _, f := range drift.Fields {
+			fields = append(fields, f.String())
+		}
+		ctx.Logger().Warn("drift detected",
+			slog.String("server", drift.ServerName),
+			slog.String("id", drift.ServerID),
+			slog.String("zone", drift.Zone),
+			slog.Any("fields", fields),
+		)
+	}
+}
+
+// DriftReport 指定のファイルパスからコンフィグを読み込み、全てのServerGroupのドリフトを検出して文字列で返す
+func DriftReport(parentCtx context.Context, configPath string, strictMode bool, logger *slog.Logger) (string, error) {
+	instance, err := newInstanceFromConfig(parentCtx, "", configPath, strictMode, logger)
+	if err != nil {
+		return "", err
+	}
+	ctx := NewRequestContext(parentCtx, &requestInfo{requestType: requestTypeUnknown, source: driftDetectionSourceName}, logger)
+	drifts, err := instance.DetectDrift(ctx, "")
+	if err != nil {
+		return "", err
+	}
+	return formatDrifts(drifts), nil
+}
+
+func formatDrifts(drifts []*InstanceDrift) string {
+	if len(drifts) == 0 {
+		return "no drift detected"
+	}
+	var b strings.Builder
+	for _, drift := range drifts {
+		fmt.Fprintf(&b, "%s: %s (id: %s, zone: %s)\n", drift.ResourceName, drift.ServerName, drift.ServerID, drift.Zone)
+		for _, f := range drift.Fields {
+			fmt.Fprintf(&b, "  - %s\n", f.String())
+		}
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
@@ -0,0 +1,142 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sacloud/autoscaler/handler"
+	"github.com/sacloud/autoscaler/test"
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/iaas-api-go/types"
+	"github.com/sacloud/packages-go/size"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceDefServerGroup_diffInstance(t *testing.T) {
+	def := &ResourceDefServerGroup{
+		ResourceDefBase:  &ResourceDefBase{TypeName: "ServerGroup", DefName: "test"},
+		ServerNamePrefix: "test",
+		Zones:            []string{"is1a"},
+		MaxSize:          3,
+		Template: &ServerGroupInstanceTemplate{
+			Tags:          []string{"tag1", "tag2"},
+			Description:   "desc",
+			Plan:          &ServerGroupInstancePlan{Core: 2, Memory: 4},
+			FallbackPlans: []*ServerGroupInstancePlan{{Core: 4, Memory: 8}},
+			NetworkInterfaces: []*ServerGroupNICTemplate{
+				{Upstream: &ServerGroupNICUpstream{shared: true}, PacketFilterId: "123456789012"},
+			},
+		},
+	}
+	matched := func() *iaas.Server {
+		return &iaas.Server{
+			Name:            "test-001",
+			Tags:            types.Tags{"tag2", "tag1", TemplateFingerprintTagPrefix + "xxxxxxxx", ScaleInProtectionTag},
+			Description:     "desc",
+			CPU:             2,
+			MemoryMB:        4 * size.GiB,
+			InterfaceDriver: types.InterfaceDrivers.VirtIO,
+			Commitment:      types.Commitments.Standard,
+			Interfaces: []*iaas.InterfaceView{
+				{UpstreamType: types.UpstreamNetworkTypes.Shared, PacketFilterID: types.StringID("123456789012")},
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(server *iaas.Server)
+		want   []*FieldDrift
+	}{
+		{
+			name:   "no drift",
+			modify: func(*iaas.Server) {},
+			want:   nil,
+		},
+		{
+			name: "plan and tags",
+			modify: func(server *iaas.Server) {
+				server.CPU = 4
+				server.Tags = types.Tags{"tag1"}
+			},
+			want: []*FieldDrift{
+				{Field: "plan.core", Expected: "2", Actual: "4"},
+				{Field: "tags", Expected: "tag1,tag2", Actual: "tag1"},
+			},
+		},
+		{
+			name: "created with fallback plan",
+			modify: func(server *iaas.Server) {
+				server.CPU = 4
+				server.MemoryMB = 8 * size.GiB
+				server.Tags = append(server.Tags, handler.ServerPlanTag(4, 8, 0, false))
+			},
+			want: nil,
+		},
+		{
+			name: "packet filter",
+			modify: func(server *iaas.Server) {
+				server.Interfaces[0].PacketFilterID = types.ID(0)
+			},
+			want: []*FieldDrift{
+				{Field: "network_interfaces[0].packet_filter_id", Expected: "123456789012", Actual: ""},
+			},
+		},
+		{
+			name: "network interfaces",
+			modify: func(server *iaas.Server) {
+				server.Interfaces = nil
+			},
+			want: []*FieldDrift{
+				{Field: "network_interfaces", Expected: "1 interfaces", Actual: "0 interfaces"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := matched()
+			tt.modify(server)
+			resource := &ResourceServerGroupInstance{
+				ResourceBase: &ResourceBase{resourceType: ResourceTypeServerGroupInstance},
+				server:       server,
+				zone:         "is1a",
+				def:          def,
+			}
+			ctx := NewRequestContext(context.Background(), &requestInfo{}, test.Logger)
+
+			got, err := def.diffInstance(ctx, resource)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFormatDrifts(t *testing.T) {
+	require.Equal(t, "no drift detected", formatDrifts(nil))
+	require.Equal(t,
+		"sg: test-001 (id: 123456789012, zone: is1a)\n  - plan.core: expected=\"2\" actual=\"4\"",
+		formatDrifts([]*InstanceDrift{
+			{
+				ResourceName: "sg",
+				ServerID:     "123456789012",
+				ServerName:   "test-001",
+				Zone:         "is1a",
+				Fields:       []*FieldDrift{{Field: "plan.core", Expected: "2", Actual: "4"}},
+			},
+		}),
+	)
+}
@@ -139,6 +139,8 @@ func (h *Handler) handle(ctx *HandlingContext, computed Computed, handleArg *han
 			Instruction:      computed.Instruction(),
 			SetupGracePeriod: uint32(computed.SetupGracePeriod()),
 			Desired:          computed.Desired(),
+			Labels:           req.labels,
+			PayloadRef:       req.payloadRef,
 		}); err != nil {
 			return err
 		}
@@ -152,6 +154,8 @@ func (h *Handler) handle(ctx *HandlingContext, computed Computed, handleArg *han
 			Instruction:      computed.Instruction(),
 			SetupGracePeriod: uint32(computed.SetupGracePeriod()),
 			Desired:          computed.Desired(),
+			Labels:           req.labels,
+			PayloadRef:       req.payloadRef,
 		}); err != nil {
 			return err
 		}
@@ -165,6 +169,8 @@ func (h *Handler) handle(ctx *HandlingContext, computed Computed, handleArg *han
 			Result:           ctx.ComputeResult(computed),
 			Current:          computed.Current(),
 			SetupGracePeriod: uint32(computed.SetupGracePeriod()),
+			Labels:           req.labels,
+			PayloadRef:       req.payloadRef,
 		}); err != nil {
 			return err
 		}
@@ -15,9 +15,11 @@
 package core
 
 import (
+	"context"
 	"testing"
 
 	"github.com/sacloud/autoscaler/handler"
+	"github.com/sacloud/autoscaler/test"
 	"github.com/stretchr/testify/require"
 )
 
@@ -60,3 +62,43 @@ func Test_handleHandlerResponseStatus_setTrueOnce(t *testing.T) {
 	handleHandlerResponseStatus(ctx, handler.HandleResponse_RECEIVED)
 	require.True(t, ctx.handled)
 }
+
+func TestHandler_handle_labels(t *testing.T) {
+	labels := map[string]string{"alertname": "HighCPU"}
+	ctx := NewRequestContext(context.Background(), &requestInfo{
+		requestType:  requestTypeUp,
+		source:       "default",
+		resourceName: "web",
+		labels:       labels,
+		payloadRef:   "http://alertmanager.example.com:9093",
+	}, test.Logger)
+	computed := &stubComputed{id: "1", instruction: handler.ResourceInstructions_NOOP}
+
+	var handleRequests []*handler.HandleRequest
+	var postHandleRequest *handler.PostHandleRequest
+	h := &Handler{}
+	err := h.handle(NewHandlingContext(ctx, computed), computed, &handleArg{
+		preHandle: func(req *handler.HandleRequest) error {
+			handleRequests = append(handleRequests, req)
+			return nil
+		},
+		handle: func(req *handler.HandleRequest) error {
+			handleRequests = append(handleRequests, req)
+			return nil
+		},
+		postHandle: func(req *handler.PostHandleRequest) error {
+			postHandleRequest = req
+			return nil
+		},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, handleRequests, 2)
+	for _, req := range handleRequests {
+		require.Equal(t, labels, req.Labels)
+		require.Equal(t, "http://alertmanager.example.com:9093", req.PayloadRef)
+	}
+	require.NotNil(t, postHandleRequest)
+	require.Equal(t, labels, postHandleRequest.Labels)
+	require.Equal(t, "http://alertmanager.example.com:9093", postHandleRequest.PayloadRef)
+}
@@ -70,6 +70,10 @@ func (c *HandlingContext) ComputeResult(computed Computed) handler.PostHandleReq
 		if c.cachedComputed.Instruction() == handler.ResourceInstructions_UPDATE {
 			return handler.PostHandleRequest_UPDATED
 		}
+		// ウォームプールのサーバを起動した場合はIDが変わらないが作成として扱う
+		if c.cachedComputed.Instruction() == handler.ResourceInstructions_CREATE {
+			return handler.PostHandleRequest_CREATED
+		}
 	case c.cachedComputed.ID() == "" && computed.ID() != "": // created?
 		return handler.PostHandleRequest_CREATED
 	case c.cachedComputed.ID() != computed.ID(): // plan changed?
@@ -50,6 +50,22 @@ func TestHandlingContext_ComputeResult(t *testing.T) {
 			},
 			want: handler.PostHandleRequest_CREATED,
 		},
+		{
+			name: "created from warm pool",
+			fields: fields{
+				currentComputed: &stubComputed{
+					id:          "1",
+					instruction: handler.ResourceInstructions_CREATE,
+				},
+			},
+			args: args{
+				computed: &stubComputed{
+					id:          "1",
+					instruction: handler.ResourceInstructions_NOOP,
+				},
+			},
+			want: handler.PostHandleRequest_CREATED,
+		},
 		{
 			name: "updated",
 			fields: fields{
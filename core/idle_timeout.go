@@ -0,0 +1,121 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/sacloud/autoscaler/defaults"
+	"github.com/sacloud/autoscaler/request"
+)
+
+// IdleTimeout ServerGroupのアイドルタイムアウト(スケールトゥゼロ)の設定
+//
+// 指定期間アクティビティ(Up/Keepリクエスト)を受け取らなかった場合、サーバ台数を0にするDownリクエストを発行する
+// 台数が0になったServerGroupはInputsの/wakeエンドポイントなどからのUpリクエストで起こすことを想定している
+type IdleTimeout struct {
+	Enabled bool `yaml:"enabled"`
+	Timeout int  `yaml:"timeout" validate:"omitempty,min=60"` // 秒数、省略時は30分
+}
+
+func (t *IdleTimeout) enabled() bool {
+	return t != nil && t.Enabled
+}
+
+func (t *IdleTimeout) Duration() time.Duration {
+	if t.Timeout <= 0 {
+		return defaults.IdleTimeout
+	}
+	return time.Duration(t.Timeout) * time.Second
+}
+
+// idleTimeoutSourceName アイドルタイムアウトによるDownリクエストのSource
+const idleTimeoutSourceName = "idle-timeout"
+
+// idleTimeoutCheckInterval アイドルタイムアウトの判定間隔
+var idleTimeoutCheckInterval = time.Minute
+
+// recordActivity リソースのアクティビティを記録する
+func (c *Core) recordActivity(resourceName string, at time.Time) {
+	c.activityMu.Lock()
+	defer c.activityMu.Unlock()
+	c.lastActivities[resourceName] = at
+}
+
+// idleResources アイドルタイムアウトが有効かつ期間を超過したServerGroupを返す
+//
+// アクティビティが未記録のリソースはnowを最終アクティビティ日時とみなす。
+// 返したリソースは最終アクティビティ日時をnowとし、次の期間を超過するまでは再度返さない
+func (c *Core) idleResources(now time.Time) []*ResourceDefServerGroup {
+	c.activityMu.Lock()
+	defer c.activityMu.Unlock()
+
+	var results []*ResourceDefServerGroup
+	for _, def := range c.config.Resources {
+		sg, ok := def.(*ResourceDefServerGroup)
+		if !ok || !sg.IdleTimeout.enabled() {
+			continue
+		}
+		last, ok := c.lastActivities[sg.Name()]
+		if !ok {
+			c.lastActivities[sg.Name()] = now
+			continue
+		}
+		if now.Sub(last) < sg.IdleTimeout.Duration() {
+			continue
+		}
+		c.lastActivities[sg.Name()] = now
+		results = append(results, sg)
+	}
+	return results
+}
+
+// runIdleTimeout アイドルタイムアウトが有効なServerGroupを定期的に確認し、期間を超過したものの台数を0にする
+func (c *Core) runIdleTimeout(ctx context.Context) {
+	ticker := time.NewTicker(idleTimeoutCheckInterval)
+	defer ticker.Stop()
+
+	// 起動時点を最終アクティビティ日時とする
+	c.idleResources(time.Now())
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			for _, sg := range c.idleResources(now) {
+				c.scaleToZero(sg)
+			}
+		}
+	}
+}
+
+func (c *Core) scaleToZero(sg *ResourceDefServerGroup) {
+	ctx := NewRequestContext(context.Background(), &requestInfo{
+		requestType:  requestTypeDown,
+		source:       idleTimeoutSourceName,
+		resourceName: sg.Name(),
+		desiredSpec:  &request.DesiredSpec{Size: 0},
+	}, c.logger)
+
+	ctx.Logger().Info("idle timeout exceeded, scaling down to zero", slog.Duration("idle-timeout", sg.IdleTimeout.Duration()))
+	if _, message, err := c.Down(ctx); err != nil {
+		ctx.Logger().Error("scaling down to zero failed", slog.Any("error", err))
+	} else if message != "" {
+		ctx.Logger().Info("scaling down to zero skipped", slog.String("message", message))
+	}
+}
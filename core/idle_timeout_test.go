@@ -0,0 +1,56 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCore_idleResources(t *testing.T) {
+	idle := &ResourceDefServerGroup{
+		ResourceDefBase: &ResourceDefBase{TypeName: "ServerGroup", DefName: "idle"},
+		IdleTimeout:     &IdleTimeout{Enabled: true, Timeout: 600},
+	}
+	disabled := &ResourceDefServerGroup{
+		ResourceDefBase: &ResourceDefBase{TypeName: "ServerGroup", DefName: "disabled"},
+		IdleTimeout:     &IdleTimeout{Enabled: false, Timeout: 600},
+	}
+	c := &Core{
+		config:         &Config{Resources: ResourceDefinitions{idle, disabled}},
+		lastActivities: make(map[string]time.Time),
+	}
+
+	start := time.Now()
+	// 未記録の場合はその時点を最終アクティビティ日時とする
+	require.Empty(t, c.idleResources(start))
+	require.Empty(t, c.idleResources(start.Add(599*time.Second)))
+
+	require.Equal(t, []*ResourceDefServerGroup{idle}, c.idleResources(start.Add(600*time.Second)))
+	// 一度返したら次の期間を超過するまでは返さない
+	require.Empty(t, c.idleResources(start.Add(601*time.Second)))
+
+	// アクティビティを記録すると期間がリセットされる
+	c.recordActivity("idle", start.Add(1000*time.Second))
+	require.Empty(t, c.idleResources(start.Add(1500*time.Second)))
+	require.Equal(t, []*ResourceDefServerGroup{idle}, c.idleResources(start.Add(1600*time.Second)))
+}
+
+func TestIdleTimeout_Duration(t *testing.T) {
+	require.Equal(t, 30*time.Minute, (&IdleTimeout{Enabled: true}).Duration())
+	require.Equal(t, 10*time.Minute, (&IdleTimeout{Enabled: true, Timeout: 600}).Duration())
+}
@@ -0,0 +1,225 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/sacloud/autoscaler/handler"
+	"github.com/sacloud/iaas-api-go"
+)
+
+// InstanceRefresh ServerGroupのインスタンスリフレッシュ(テンプレート変更時の既存サーバの入れ替え)の設定
+//
+// 有効な場合、サーバ作成時にテンプレートのフィンガープリントをタグとして付与しておき、
+// Refreshリクエスト時(OnKeepがtrueの場合はKeepリクエスト時も)にフィンガープリントが異なるサーバを新しいサーバに入れ替える
+type InstanceRefresh struct {
+	Enabled bool `yaml:"enabled"`
+	// BatchSize 一度に入れ替えるサーバ台数、省略時は1
+	BatchSize int `yaml:"batch_size" validate:"omitempty,min=1"`
+	// MinHealthyPercentage 入れ替えを開始する際にヘルスチェックに成功しているべきサーバの割合(%)、省略時は100
+	MinHealthyPercentage int `yaml:"min_healthy_percentage" validate:"omitempty,min=1,max=100"`
+	// OnKeep trueの場合、Keepリクエスト時にテンプレートの変更を検知したら1バッチ分の入れ替えを行う
+	OnKeep bool `yaml:"on_keep"`
+}
+
+func (r *InstanceRefresh) enabled() bool {
+	return r != nil && r.Enabled
+}
+
+// createsIndexGaps インスタンスリフレッシュによりサーバ名のインデックスに途中抜けが生じうる場合trueを返す
+//
+// 入れ替え後のサーバは空いているインデックスに作成されるため、有効な場合は常にtrueとなる
+func (r *InstanceRefresh) createsIndexGaps() bool {
+	return r.enabled()
+}
+
+func (r *InstanceRefresh) onKeep() bool {
+	return r.enabled() && r.OnKeep
+}
+
+func (r *InstanceRefresh) batchSize() int {
+	if r.BatchSize <= 0 {
+		return 1
+	}
+	return r.BatchSize
+}
+
+func (r *InstanceRefresh) minHealthyPercentage() int {
+	if r.MinHealthyPercentage <= 0 {
+		return 100
+	}
+	return r.MinHealthyPercentage
+}
+
+// TemplateFingerprintTagPrefix テンプレートのフィンガープリントを示すタグのプレフィックス
+const TemplateFingerprintTagPrefix = "@template="
+
+// templateFingerprint テンプレートの内容から算出したフィンガープリントを返す
+//
+// コンフィギュレーションに記載されたテンプレートに加え、ディスクのソースアーカイブ/ディスクのIDをzoneで解決した結果を含める。
+// これによりテンプレートの記載が同じでもセレクタで新しいアーカイブが見つかった場合は変更として扱う
+func (d *ResourceDefServerGroup) templateFingerprint(ctx context.Context, apiClient iaas.APICaller, zone string) (string, error) {
+	data := d.Template.raw
+	if data == nil {
+		// コンフィギュレーションを経由せずに組み立てた場合
+		marshaled, err := yaml.Marshal(d.Template)
+		if err != nil {
+			return "", err
+		}
+		data = marshaled
+	}
+
+	hash := sha256.New()
+	hash.Write(data)
+	for _, disk := range d.Template.Disks {
+		sourceArchiveID, sourceDiskID, err := disk.FindDiskSource(ctx, apiClient, zone)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(hash, "\n%s/%s", sourceArchiveID, sourceDiskID)
+	}
+	return hex.EncodeToString(hash.Sum(nil))[:8], nil
+}
+
+// templateFingerprintOf サーバに付与されたフィンガープリントを返す、付与されていない場合は空文字を返す
+func templateFingerprintOf(server *iaas.Server) string {
+	for _, tag := range server.Tags {
+		if strings.HasPrefix(tag, TemplateFingerprintTagPrefix) {
+			return strings.TrimPrefix(tag, TemplateFingerprintTagPrefix)
+		}
+	}
+	return ""
+}
+
+// tagsForNewServer zoneに新規作成するサーバに付与するタグを返す
+func (d *ResourceDefServerGroup) tagsForNewServer(ctx context.Context, apiClient iaas.APICaller, zone string, index int) ([]string, error) {
+	tags := d.Template.CalculateTagsByIndex(index, len(d.Zones))
+	if !d.InstanceRefresh.enabled() {
+		return tags, nil
+	}
+	fingerprint, err := d.templateFingerprint(ctx, apiClient, zone)
+	if err != nil {
+		return nil, err
+	}
+	return append(slices.Clone(tags), TemplateFingerprintTagPrefix+fingerprint), nil
+}
+
+// outdatedInstances テンプレートのフィンガープリントが現在のものと異なるサーバを返す
+//
+// フィンガープリントはサーバが存在するゾーンごとに算出する。
+// includeUnknownがtrueの場合はフィンガープリントが付与されていないサーバも含める
+func (d *ResourceDefServerGroup) outdatedInstances(ctx context.Context, apiClient iaas.APICaller, existing Resources, includeUnknown bool) ([]*ResourceServerGroupInstance, error) {
+	fingerprints := make(map[string]string)
+	var outdated []*ResourceServerGroupInstance
+	for _, r := range existing {
+		instance := r.(*ResourceServerGroupInstance)
+		fingerprint, ok := fingerprints[instance.zone]
+		if !ok {
+			var err error
+			fingerprint, err = d.templateFingerprint(ctx, apiClient, instance.zone)
+			if err != nil {
+				return nil, err
+			}
+			fingerprints[instance.zone] = fingerprint
+		}
+
+		current := templateFingerprintOf(instance.server)
+		if current == fingerprint || (current == "" && !includeUnknown) {
+			continue
+		}
+		outdated = append(outdated, instance)
+	}
+	return outdated, nil
+}
+
+// buildInstancesForRefresh テンプレートが変更された既存サーバを入れ替えるためのリソースを返す
+//
+// 1回の呼び出しではBatchSize台分のみを対象とし、対象がなくなった場合は空を返す。
+// 入れ替え後のサーバを空いているインデックスで先に作成し、その後で既存サーバを削除することで台数を減らさないようにする。
+// MaxSizeまで空きがない場合は既存サーバを削除してから同じ名前で作成し直す。
+// 入れ替え済みのサーバがヘルスチェックに失敗している場合やMinHealthyPercentageを満たさない場合はエラーを返して中断する
+func (d *ResourceDefServerGroup) buildInstancesForRefresh(ctx *RequestContext, apiClient iaas.APICaller, parent Resource, iconId string, existing Resources, includeUnknown bool) (Resources, error) {
+	outdated, err := d.outdatedInstances(ctx, apiClient, existing, includeUnknown)
+	if err != nil {
+		return nil, err
+	}
+	if len(outdated) == 0 {
+		return nil, nil
+	}
+
+	healthy := 0
+	for _, r := range existing {
+		instance := r.(*ResourceServerGroupInstance)
+		ok, err := instance.isHealthy(ctx.WithZone(instance.zone))
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			healthy++
+			continue
+		}
+		if !slices.Contains(outdated, instance) {
+			return nil, fmt.Errorf("instance refresh paused: refreshed server %q is unhealthy", instance.server.Name)
+		}
+	}
+	if healthy*100 < len(existing)*d.InstanceRefresh.minHealthyPercentage() {
+		return nil, fmt.Errorf("instance refresh paused: healthy servers %d/%d are below min_healthy_percentage %d",
+			healthy, len(existing), d.InstanceRefresh.minHealthyPercentage())
+	}
+
+	batch := outdated[:min(d.InstanceRefresh.batchSize(), len(outdated))]
+	ctx.Logger().Info("refreshing instances",
+		slog.Int("batch", len(batch)),
+		slog.Int("remaining", len(outdated)-len(batch)),
+	)
+
+	current := slices.Clone(existing)
+	var created, deleted, replaced Resources
+	for _, instance := range batch {
+		instance.instruction = handler.ResourceInstructions_DELETE
+
+		// 入れ替え前のサーバが残っている間は同じ名前を使えないため、空いているインデックスで作成する
+		name, index := d.determineServerName(current)
+		if index >= d.MaxSize {
+			// 空きがない場合は削除してから同じ名前で作成し直す
+			ctx.Logger().Info("no available server index within max_size, replacing in place",
+				slog.String("server", instance.server.Name),
+			)
+			resource, err := d.createResourceWithCreateInstruction(ctx.WithZone(instance.zone), apiClient, parent, iconId, instance.zone, instance.server.Name, instance.indexInGroup)
+			if err != nil {
+				return nil, err
+			}
+			replaced = append(replaced, instance, resource)
+			continue
+		}
+
+		resource, err := d.createResourceWithCreateInstruction(ctx.WithZone(instance.zone), apiClient, parent, iconId, instance.zone, name, index)
+		if err != nil {
+			return nil, err
+		}
+		created = append(created, resource)
+		deleted = append(deleted, instance)
+		current = append(current, resource)
+	}
+	return append(append(created, deleted...), replaced...), nil
+}
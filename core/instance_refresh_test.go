@@ -0,0 +1,333 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/goccy/go-yaml"
+	"github.com/sacloud/autoscaler/handler"
+	"github.com/sacloud/autoscaler/handlers/builtins"
+	"github.com/sacloud/autoscaler/request"
+	"github.com/sacloud/autoscaler/test"
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/iaas-api-go/helper/power"
+	"github.com/sacloud/iaas-api-go/types"
+	"github.com/sacloud/packages-go/size"
+	"github.com/stretchr/testify/require"
+)
+
+func testInstanceRefreshDef(refresh *InstanceRefresh) *ResourceDefServerGroup {
+	return &ResourceDefServerGroup{
+		ResourceDefBase:  &ResourceDefBase{TypeName: "ServerGroup", DefName: "test"},
+		ServerNamePrefix: "test",
+		Zones:            []string{"is1a"},
+		MaxSize:          5,
+		Template: &ServerGroupInstanceTemplate{
+			Plan: &ServerGroupInstancePlan{Core: 1, Memory: 1},
+		},
+		InstanceRefresh: refresh,
+	}
+}
+
+// testInstanceRefreshResources fingerprintsの要素ごとにサーバを作成する、要素が空文字の場合はフィンガープリントのタグを付与しない
+func testInstanceRefreshResources(fingerprints ...string) Resources {
+	var resources Resources
+	for i, fingerprint := range fingerprints {
+		server := &iaas.Server{
+			Name:           fmt.Sprintf("test-%03d", i+1),
+			InstanceStatus: types.ServerInstanceStatuses.Up,
+		}
+		if fingerprint != "" {
+			server.Tags = types.Tags{TemplateFingerprintTagPrefix + fingerprint}
+		}
+		resources = append(resources, &ResourceServerGroupInstance{
+			ResourceBase: &ResourceBase{resourceType: ResourceTypeServerGroupInstance},
+			server:       server,
+			zone:         "is1a",
+			instruction:  handler.ResourceInstructions_NOOP,
+			indexInGroup: i,
+		})
+	}
+	return resources
+}
+
+func testRefreshInstructions(resources Resources) []string {
+	var results []string
+	for _, r := range resources {
+		instance := r.(*ResourceServerGroupInstance)
+		results = append(results, fmt.Sprintf("%s:%s", instance.instruction, instance.server.Name))
+	}
+	return results
+}
+
+func TestResourceDefServerGroup_buildInstancesForRefresh(t *testing.T) {
+	current, err := testInstanceRefreshDef(nil).templateFingerprint(context.Background(), nil, "is1a")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		refresh        *InstanceRefresh
+		fingerprints   []string
+		setup          func(resources Resources)
+		includeUnknown bool
+		want           []string
+		wantErr        bool
+	}{
+		{
+			name:         "up to date",
+			refresh:      &InstanceRefresh{Enabled: true},
+			fingerprints: []string{current, current},
+			want:         nil,
+		},
+		{
+			name:         "default batch size",
+			refresh:      &InstanceRefresh{Enabled: true},
+			fingerprints: []string{"old", "old", current},
+			want:         []string{"CREATE:test-004", "DELETE:test-001"},
+		},
+		{
+			name:         "batch size",
+			refresh:      &InstanceRefresh{Enabled: true, BatchSize: 2},
+			fingerprints: []string{current, "old", "old", "old"},
+			want:         []string{"CREATE:test-005", "DELETE:test-002", "DELETE:test-003", "CREATE:test-003"},
+		},
+		{
+			name:         "servers without fingerprint are skipped",
+			refresh:      &InstanceRefresh{Enabled: true},
+			fingerprints: []string{"", current},
+			want:         nil,
+		},
+		{
+			name:           "servers without fingerprint are included",
+			refresh:        &InstanceRefresh{Enabled: true},
+			fingerprints:   []string{"", current},
+			includeUnknown: true,
+			want:           []string{"CREATE:test-003", "DELETE:test-001"},
+		},
+		{
+			name:         "free index is used",
+			refresh:      &InstanceRefresh{Enabled: true},
+			fingerprints: []string{"old", current, current},
+			setup: func(resources Resources) {
+				resources[1].(*ResourceServerGroupInstance).server.Name = "test-004"
+			},
+			want: []string{"CREATE:test-002", "DELETE:test-001"},
+		},
+		{
+			name:         "replaced in place without available index",
+			refresh:      &InstanceRefresh{Enabled: true},
+			fingerprints: []string{"old", current, current, current, current},
+			want:         []string{"DELETE:test-001", "CREATE:test-001"},
+		},
+		{
+			name:         "paused by unhealthy refreshed server",
+			refresh:      &InstanceRefresh{Enabled: true},
+			fingerprints: []string{current, "old"},
+			setup: func(resources Resources) {
+				resources[0].(*ResourceServerGroupInstance).server.InstanceStatus = types.ServerInstanceStatuses.Down
+			},
+			wantErr: true,
+		},
+		{
+			name:         "paused by min_healthy_percentage",
+			refresh:      &InstanceRefresh{Enabled: true},
+			fingerprints: []string{"old", "old"},
+			setup: func(resources Resources) {
+				resources[0].(*ResourceServerGroupInstance).server.InstanceStatus = types.ServerInstanceStatuses.Down
+			},
+			wantErr: true,
+		},
+		{
+			name:         "unhealthy outdated server within min_healthy_percentage",
+			refresh:      &InstanceRefresh{Enabled: true, MinHealthyPercentage: 50},
+			fingerprints: []string{"old", "old"},
+			setup: func(resources Resources) {
+				resources[0].(*ResourceServerGroupInstance).server.InstanceStatus = types.ServerInstanceStatuses.Down
+			},
+			want: []string{"CREATE:test-003", "DELETE:test-001"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := testInstanceRefreshDef(tt.refresh)
+			existing := testInstanceRefreshResources(tt.fingerprints...)
+			if tt.setup != nil {
+				tt.setup(existing)
+			}
+			ctx := NewRequestContext(context.Background(), &requestInfo{requestType: requestTypeRefresh}, test.Logger)
+
+			got, err := d.buildInstancesForRefresh(ctx, nil, nil, "", existing, tt.includeUnknown)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, testRefreshInstructions(got))
+
+			for _, r := range got {
+				instance := r.(*ResourceServerGroupInstance)
+				if instance.instruction == handler.ResourceInstructions_CREATE {
+					require.Equal(t, current, templateFingerprintOf(instance.server))
+				}
+			}
+		})
+	}
+}
+
+func TestResourceDefServerGroup_templateFingerprint(t *testing.T) {
+	ctx := context.Background()
+	fingerprint := func(t *testing.T, data string) string {
+		var template ServerGroupInstanceTemplate
+		require.NoError(t, yaml.UnmarshalWithOptions([]byte(data), &template, yaml.Strict()))
+		d := testInstanceRefreshDef(&InstanceRefresh{Enabled: true})
+		d.Template = &template
+		got, err := d.templateFingerprint(ctx, test.APIClient, test.Zone)
+		require.NoError(t, err)
+		require.Len(t, got, 8)
+		return got
+	}
+
+	before := fingerprint(t, `
+plan:
+  core: 1
+  memory: 1
+description: "desc"
+`)
+
+	t.Run("key order and comments are ignored", func(t *testing.T) {
+		require.Equal(t, before, fingerprint(t, `
+# comment
+description: "desc"
+plan: {memory: 1, core: 1}
+`))
+	})
+
+	t.Run("fields not written in the configuration are ignored", func(t *testing.T) {
+		var template ServerGroupInstanceTemplate
+		require.NoError(t, yaml.Unmarshal([]byte("plan: {core: 1, memory: 1}\ndescription: desc"), &template))
+		d := testInstanceRefreshDef(&InstanceRefresh{Enabled: true})
+		d.Template = &template
+		d.Template.FallbackPlans = []*ServerGroupInstancePlan{}
+		d.Template.ZonePlans = map[string][]*ServerGroupInstancePlan{}
+		got, err := d.templateFingerprint(ctx, test.APIClient, test.Zone)
+		require.NoError(t, err)
+		require.Equal(t, before, got)
+	})
+
+	t.Run("changed template", func(t *testing.T) {
+		require.NotEqual(t, before, fingerprint(t, `
+plan:
+  core: 2
+  memory: 1
+description: "desc"
+`))
+	})
+
+	t.Run("source archive found by the same selector", func(t *testing.T) {
+		archiveOp := iaas.NewArchiveOp(test.APIClient)
+		found, err := archiveOp.Find(ctx, test.Zone, &iaas.FindCondition{Count: 1})
+		require.NoError(t, err)
+		require.NotEmpty(t, found.Archives)
+
+		createArchive := func() *iaas.Archive {
+			archive, err := archiveOp.Create(ctx, test.Zone, &iaas.ArchiveCreateRequest{
+				Name:            "instance-refresh-fingerprint",
+				SourceArchiveID: found.Archives[0].ID,
+			})
+			require.NoError(t, err)
+			return archive
+		}
+		data := `
+plan:
+  core: 1
+  memory: 1
+disks:
+  - source_archive:
+      names: ["instance-refresh-fingerprint"]
+`
+		archive := createArchive()
+		first := fingerprint(t, data)
+		require.Equal(t, first, fingerprint(t, data))
+
+		require.NoError(t, archiveOp.Delete(ctx, test.Zone, archive.ID))
+		archive = createArchive()
+		defer archiveOp.Delete(ctx, test.Zone, archive.ID) //nolint:errcheck
+		require.NotEqual(t, first, fingerprint(t, data))
+	})
+}
+
+func TestResourceDefinitions_HandleAll_instanceRefresh(t *testing.T) {
+	serverOp := iaas.NewServerOp(test.APIClient)
+	for _, name := range []string{"refresh-001", "refresh-002"} {
+		server, err := serverOp.Create(context.Background(), test.Zone, &iaas.ServerCreateRequest{
+			CPU:             1,
+			MemoryMB:        1 * size.GiB,
+			Commitment:      types.Commitments.Standard,
+			Generation:      types.PlanGenerations.Default,
+			InterfaceDriver: types.InterfaceDrivers.VirtIO,
+			Name:            name,
+			Tags:            types.Tags{TemplateFingerprintTagPrefix + "old"},
+		})
+		require.NoError(t, err)
+		require.NoError(t, power.BootServer(context.Background(), serverOp, test.Zone, server.ID))
+	}
+
+	d := &ResourceDefServerGroup{
+		ResourceDefBase: &ResourceDefBase{TypeName: ResourceTypeServerGroup.String(), DefName: "refresh"},
+		Zones:           []string{test.Zone},
+		MaxSize:         4,
+		Template: &ServerGroupInstanceTemplate{
+			Plan: &ServerGroupInstancePlan{Core: 1, Memory: 1},
+		},
+		InstanceRefresh: &InstanceRefresh{Enabled: true},
+	}
+	t.Cleanup(func() {
+		servers, err := d.findCloudResources(context.Background(), test.APIClient)
+		require.NoError(t, err)
+		for _, server := range servers {
+			serverOp.Delete(context.Background(), test.Zone, server.ID) //nolint:errcheck
+		}
+	})
+
+	var handlers Handlers
+	for _, h := range BuiltinHandlers() {
+		if h, ok := h.BuiltinHandler.(builtins.SakuraCloudAPICaller); ok {
+			h.SetAPICaller(test.APIClient)
+		}
+		handlers = append(handlers, h)
+	}
+
+	req := &requestInfo{requestType: requestTypeRefresh, source: "default", resourceName: "refresh", sync: true}
+	job := NewJobStatus(req, nil)
+	ctx := NewRequestContext(context.Background(), req, test.Logger).WithJobStatus(job)
+	rds := ResourceDefinitions{d}
+	rds.HandleAll(ctx, test.APIClient, handlers, nil)
+	require.Equal(t, request.ScalingJobStatus_JOB_DONE, job.Status())
+
+	// 入れ替え後のサーバは空いているインデックスで作成され、既存サーバは削除されている
+	fingerprint, err := d.templateFingerprint(context.Background(), test.APIClient, test.Zone)
+	require.NoError(t, err)
+	servers, err := d.findCloudResources(context.Background(), test.APIClient)
+	require.NoError(t, err)
+	var names []string
+	for _, server := range servers {
+		require.Equal(t, fingerprint, templateFingerprintOf(server))
+		names = append(names, server.Name)
+	}
+	require.ElementsMatch(t, []string{"refresh-001", "refresh-003"}, names)
+}
@@ -30,6 +30,10 @@ type JobStatus struct {
 	status   request.ScalingJobStatus
 	coolDown *CoolDown
 	mu       sync.Mutex
+
+	// labels/payloadRef ジョブを起動したリクエストのラベルとペイロードの参照先(監査用)
+	labels     map[string]string
+	payloadRef string
 }
 
 func NewJobStatus(req *requestInfo, coolDown *CoolDown) *JobStatus {
@@ -61,6 +65,32 @@ func (j *JobStatus) SetStatus(status request.ScalingJobStatus) {
 	j.status = status
 }
 
+// Labels ジョブを起動したリクエストのラベルを返す
+func (j *JobStatus) Labels() map[string]string {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	return j.labels
+}
+
+// PayloadRef ジョブを起動したリクエストのペイロードの参照先を返す
+func (j *JobStatus) PayloadRef() string {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	return j.payloadRef
+}
+
+// Accept ジョブをACCEPTEDにし、どのリクエストにより起動されたかを記録する
+func (j *JobStatus) Accept(req *requestInfo) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.status = request.ScalingJobStatus_JOB_ACCEPTED
+	j.labels = req.labels
+	j.payloadRef = req.payloadRef
+}
+
 func (j *JobStatus) String() string {
 	return fmt.Sprintf("ID: %s Status: %s", j.ID(), j.Status())
 }
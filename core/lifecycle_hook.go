@@ -0,0 +1,205 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/sacloud/autoscaler/defaults"
+)
+
+const (
+	// LifecycleHookLaunching サーバ作成後、ELBやLBなどへの登録(PostHandle)の前に処理を一時停止するフック
+	LifecycleHookLaunching = "launching"
+	// LifecycleHookTerminating サーバ削除前、ELBやLBなどからの切り離し(PreHandle)の前に処理を一時停止するフック
+	LifecycleHookTerminating = "terminating"
+
+	// LifecycleActionContinue 処理を継続する
+	LifecycleActionContinue = "continue"
+	// LifecycleActionAbandon 処理を中断する(ジョブは失敗となる)
+	LifecycleActionAbandon = "abandon"
+)
+
+// LifecycleHooks ServerGroupのライフサイクルフックの設定
+//
+// フックが有効な場合、Coreは対象サーバの処理を一時停止し、CompleteLifecycleActionリクエストを受け取るかタイムアウトするまで待機する
+type LifecycleHooks struct {
+	Launching   *LifecycleHook `yaml:"launching"`
+	Terminating *LifecycleHook `yaml:"terminating"`
+
+	mu      sync.Mutex
+	pending []*pendingLifecycleAction
+}
+
+// LifecycleHook 個々のライフサイクルフックの設定
+type LifecycleHook struct {
+	Timeout       int    `yaml:"timeout" validate:"omitempty,min=1"`                         // 秒数、省略時は5分
+	DefaultAction string `yaml:"default_action" validate:"omitempty,oneof=continue abandon"` // タイムアウト時のアクション、省略時はcontinue
+}
+
+func (h *LifecycleHook) Duration() time.Duration {
+	if h.Timeout <= 0 {
+		return defaults.LifecycleHookTimeout
+	}
+	return time.Duration(h.Timeout) * time.Second
+}
+
+func (h *LifecycleHook) defaultAction() string {
+	if h.DefaultAction == "" {
+		return LifecycleActionContinue
+	}
+	return h.DefaultAction
+}
+
+// pendingLifecycleAction 完了待ちのライフサイクルアクション
+type pendingLifecycleAction struct {
+	hook       string
+	serverID   string
+	serverName string
+	result     chan string
+}
+
+func (hooks *LifecycleHooks) hook(hookType string) *LifecycleHook {
+	if hooks == nil {
+		return nil
+	}
+	switch hookType {
+	case LifecycleHookLaunching:
+		return hooks.Launching
+	case LifecycleHookTerminating:
+		return hooks.Terminating
+	}
+	return nil
+}
+
+// wait 指定のフックが有効な場合、対象サーバのライフサイクルアクションが完了するかタイムアウトするまで待機する
+//
+// アクションがabandonの場合はエラーを返す
+func (hooks *LifecycleHooks) wait(ctx *HandlingContext, hookType string, computed Computed) error {
+	hook := hooks.hook(hookType)
+	if hook == nil {
+		return nil
+	}
+
+	action := &pendingLifecycleAction{
+		hook:       hookType,
+		serverID:   computed.ID(),
+		serverName: computed.Name(),
+		result:     make(chan string, 1),
+	}
+	hooks.register(action)
+	defer hooks.unregister(action)
+
+	ctx.Logger().Info("waiting for lifecycle action",
+		slog.String("hook", hookType),
+		slog.Duration("timeout", hook.Duration()),
+	)
+
+	var result string
+	timer := time.NewTimer(hook.Duration())
+	defer timer.Stop()
+	select {
+	case result = <-action.result:
+		ctx.Logger().Info("lifecycle action completed", slog.String("hook", hookType), slog.String("action", result))
+	case <-timer.C:
+		result = hook.defaultAction()
+		ctx.Logger().Warn("lifecycle action timed out", slog.String("hook", hookType), slog.String("action", result))
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	if result == LifecycleActionAbandon {
+		return fmt.Errorf("lifecycle action for server %q was abandoned: hook=%s", computed.Name(), hookType)
+	}
+	return nil
+}
+
+func (hooks *LifecycleHooks) register(action *pendingLifecycleAction) {
+	hooks.mu.Lock()
+	defer hooks.mu.Unlock()
+	hooks.pending = append(hooks.pending, action)
+}
+
+func (hooks *LifecycleHooks) unregister(action *pendingLifecycleAction) {
+	hooks.mu.Lock()
+	defer hooks.mu.Unlock()
+	for i, a := range hooks.pending {
+		if a == action {
+			hooks.pending = append(hooks.pending[:i], hooks.pending[i+1:]...)
+			return
+		}
+	}
+}
+
+// complete 完了待ちのライフサイクルアクションを完了させる
+//
+// サーバはIDまたは名前で指定する。完了待ちのアクションが見つからなかった場合はfalseを返す
+func (hooks *LifecycleHooks) complete(hookType, serverID, serverName, result string) bool {
+	if hooks == nil {
+		return false
+	}
+	hooks.mu.Lock()
+	defer hooks.mu.Unlock()
+	for _, a := range hooks.pending {
+		if a.hook != hookType {
+			continue
+		}
+		if (serverID != "" && a.serverID == serverID) || (serverID == "" && serverName != "" && a.serverName == serverName) {
+			select {
+			case a.result <- result:
+			default:
+				// すでに完了済み
+				return false
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// CompleteLifecycleAction 指定のServerGroupで完了待ちとなっているライフサイクルアクションを完了させる
+func (c *Core) CompleteLifecycleAction(resourceName, hookType, serverID, serverName, result string) error {
+	if hookType != LifecycleHookLaunching && hookType != LifecycleHookTerminating {
+		return fmt.Errorf("invalid lifecycle hook: %q", hookType)
+	}
+	if result == "" {
+		result = LifecycleActionContinue
+	}
+	if result != LifecycleActionContinue && result != LifecycleActionAbandon {
+		return fmt.Errorf("invalid lifecycle action: %q", result)
+	}
+	if serverID == "" && serverName == "" {
+		return fmt.Errorf("server id or server name is required")
+	}
+
+	name, err := c.ResourceName(resourceName)
+	if err != nil {
+		return err
+	}
+	for _, def := range c.config.Resources {
+		sg, ok := def.(*ResourceDefServerGroup)
+		if !ok || sg.Name() != name {
+			continue
+		}
+		if !sg.LifecycleHooks.complete(hookType, serverID, serverName, result) {
+			return fmt.Errorf("pending lifecycle action not found: resource=%s hook=%s", name, hookType)
+		}
+		return nil
+	}
+	return fmt.Errorf("ServerGroup %q not found", name)
+}
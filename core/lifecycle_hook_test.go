@@ -0,0 +1,101 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sacloud/autoscaler/test"
+	"github.com/stretchr/testify/require"
+)
+
+func testLifecycleHandlingContext() *HandlingContext {
+	computed := &stubComputed{id: "123456789012", name: "test-001"}
+	ctx := NewRequestContext(context.Background(), &requestInfo{resourceName: "test"}, test.Logger)
+	return NewHandlingContext(ctx, computed)
+}
+
+// waitLifecycleAction hooks.waitを非同期に実行し、完了待ちのアクションが登録されるまで待つ
+func waitLifecycleAction(t *testing.T, hooks *LifecycleHooks, hookType string) chan error {
+	ctx := testLifecycleHandlingContext()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- hooks.wait(ctx, hookType, ctx.CurrentComputed())
+	}()
+	require.Eventually(t, func() bool {
+		hooks.mu.Lock()
+		defer hooks.mu.Unlock()
+		return len(hooks.pending) > 0
+	}, time.Second, 10*time.Millisecond)
+	return errCh
+}
+
+func TestLifecycleHooks_wait(t *testing.T) {
+	t.Run("returns immediately without hooks", func(t *testing.T) {
+		ctx := testLifecycleHandlingContext()
+		var hooks *LifecycleHooks
+		require.NoError(t, hooks.wait(ctx, LifecycleHookLaunching, ctx.CurrentComputed()))
+
+		hooks = &LifecycleHooks{Terminating: &LifecycleHook{}}
+		require.NoError(t, hooks.wait(ctx, LifecycleHookLaunching, ctx.CurrentComputed()))
+	})
+
+	t.Run("resumes with continue action", func(t *testing.T) {
+		hooks := &LifecycleHooks{Launching: &LifecycleHook{Timeout: 60}}
+		errCh := waitLifecycleAction(t, hooks, LifecycleHookLaunching)
+
+		// フックの種類が異なる場合は対象外
+		require.False(t, hooks.complete(LifecycleHookTerminating, "123456789012", "", LifecycleActionContinue))
+		require.True(t, hooks.complete(LifecycleHookLaunching, "", "test-001", LifecycleActionContinue))
+		require.NoError(t, <-errCh)
+		require.Empty(t, hooks.pending)
+	})
+
+	t.Run("returns error with abandon action", func(t *testing.T) {
+		hooks := &LifecycleHooks{Terminating: &LifecycleHook{Timeout: 60}}
+		errCh := waitLifecycleAction(t, hooks, LifecycleHookTerminating)
+
+		require.True(t, hooks.complete(LifecycleHookTerminating, "123456789012", "", LifecycleActionAbandon))
+		require.Error(t, <-errCh)
+	})
+
+	t.Run("uses default action on timeout", func(t *testing.T) {
+		hooks := &LifecycleHooks{Launching: &LifecycleHook{Timeout: 1, DefaultAction: LifecycleActionAbandon}}
+		errCh := waitLifecycleAction(t, hooks, LifecycleHookLaunching)
+		require.Error(t, <-errCh)
+		require.Empty(t, hooks.pending)
+	})
+}
+
+func TestCore_CompleteLifecycleAction(t *testing.T) {
+	sg := &ResourceDefServerGroup{
+		ResourceDefBase: &ResourceDefBase{TypeName: "ServerGroup", DefName: "test"},
+		LifecycleHooks:  &LifecycleHooks{Launching: &LifecycleHook{Timeout: 60}},
+	}
+	c := &Core{config: &Config{Resources: ResourceDefinitions{sg}}}
+
+	// 完了待ちのアクションがない
+	require.Error(t, c.CompleteLifecycleAction("test", LifecycleHookLaunching, "123456789012", "", ""))
+	// パラメータ不正
+	require.Error(t, c.CompleteLifecycleAction("test", "unknown", "123456789012", "", ""))
+	require.Error(t, c.CompleteLifecycleAction("test", LifecycleHookLaunching, "", "", ""))
+	require.Error(t, c.CompleteLifecycleAction("test", LifecycleHookLaunching, "123456789012", "", "unknown"))
+
+	errCh := waitLifecycleAction(t, sg.LifecycleHooks, LifecycleHookLaunching)
+	require.NoError(t, c.CompleteLifecycleAction("test", LifecycleHookLaunching, "123456789012", "", ""))
+	require.NoError(t, <-errCh)
+}
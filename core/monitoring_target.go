@@ -0,0 +1,114 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sacloud/autoscaler/defaults"
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/iaas-api-go/types"
+)
+
+// MonitoringTarget アクティビティモニタを参照する対象のさくらのクラウド上のリソース
+type MonitoringTarget struct {
+	Type ResourceTypes
+	ID   types.ID
+	Name string
+	// Zone リソースが属するゾーン、ELBの場合は空
+	Zone string
+	// InterfaceID サーバの先頭のNICのID、NICを持たない場合やサーバ以外の場合は空
+	InterfaceID types.ID
+}
+
+// MonitoringTargets 指定のリソース名のリソース定義が対象とするさくらのクラウド上のリソースを返す
+//
+// Server/ServerGroupの場合は各サーバに加えて親リソースのELBを、ELB/Routerの場合はそのリソースを返す。
+// リソース名が空またはdefaultの場合、リソース定義が1つだけであればそのリソース定義を対象とする
+func (rds *ResourceDefinitions) MonitoringTargets(ctx context.Context, apiClient iaas.APICaller, resourceName string) ([]*MonitoringTarget, error) {
+	var def ResourceDefinition
+	if resourceName == "" || resourceName == defaults.ResourceName {
+		if len(*rds) == 1 {
+			def = (*rds)[0]
+		}
+	} else if defs := rds.FilterByResourceName(resourceName); len(defs) > 0 {
+		def = defs[0]
+	}
+	if def == nil {
+		return nil, fmt.Errorf("resource %q not found", resourceName)
+	}
+
+	var targets []*MonitoringTarget
+	var parent *ParentResourceDef
+	switch d := def.(type) {
+	case *ResourceDefServer:
+		servers, err := d.findCloudResources(ctx, apiClient)
+		if err != nil {
+			return nil, err
+		}
+		for _, server := range servers {
+			targets = append(targets, serverMonitoringTarget(server.Server, server.zone))
+		}
+		parent = d.ParentDef
+	case *ResourceDefServerGroup:
+		servers, err := d.findCloudResources(ctx, apiClient)
+		if err != nil {
+			return nil, err
+		}
+		for _, server := range servers {
+			targets = append(targets, serverMonitoringTarget(server, server.Zone.Name))
+		}
+		parent = d.ParentDef
+	case *ResourceDefELB:
+		elbs, err := d.findCloudResources(ctx, apiClient)
+		if err != nil {
+			return nil, err
+		}
+		for _, elb := range elbs {
+			targets = append(targets, &MonitoringTarget{Type: ResourceTypeELB, ID: elb.ID, Name: elb.Name})
+		}
+	case *ResourceDefRouter:
+		routers, err := d.findCloudResources(ctx, apiClient)
+		if err != nil {
+			return nil, err
+		}
+		for _, router := range routers {
+			targets = append(targets, &MonitoringTarget{Type: ResourceTypeRouter, ID: router.ID, Name: router.Name, Zone: router.zone})
+		}
+	default:
+		return nil, fmt.Errorf("resource %q: monitoring is not supported for %s", resourceName, def.Type())
+	}
+
+	// ELB配下のサーバの場合は接続数を参照できるようにELBも対象とする
+	if parent != nil && parent.Type() == ResourceTypeELB {
+		elbs, err := parent.findCloudResources(ctx, apiClient, "")
+		if err != nil {
+			return nil, err
+		}
+		for _, elb := range elbs {
+			targets = append(targets, &MonitoringTarget{Type: ResourceTypeELB, ID: elb.GetID(), Name: elb.GetName()})
+		}
+	}
+	return targets, nil
+}
+
+func serverMonitoringTarget(server *iaas.Server, zone string) *MonitoringTarget {
+	target := &MonitoringTarget{Type: ResourceTypeServer, ID: server.ID, Name: server.Name, Zone: zone}
+	if len(server.Interfaces) > 0 {
+		target.InterfaceID = server.Interfaces[0].ID
+	}
+	return target
+}
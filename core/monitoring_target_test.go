@@ -0,0 +1,101 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sacloud/autoscaler/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceDefinitions_MonitoringTargets(t *testing.T) {
+	server, cleanup1 := test.AddTestServer(t, "test-monitoring-target-server")
+	defer cleanup1()
+	cleanup2 := test.AddTestELB(t, "test-monitoring-target-elb")
+	defer cleanup2()
+
+	serverDef := &ResourceDefServer{
+		ResourceDefBase: &ResourceDefBase{TypeName: "Server", DefName: "server"},
+		Selector: &MultiZoneSelector{
+			ResourceSelector: &ResourceSelector{Names: []string{"test-monitoring-target-server"}},
+			Zones:            []string{test.Zone},
+		},
+		ParentDef: &ParentResourceDef{
+			TypeName: "EnhancedLoadBalancer",
+			Selector: &NameOrSelector{ResourceSelector: ResourceSelector{Names: []string{"test-monitoring-target-elb"}}},
+		},
+	}
+	elbDef := &ResourceDefELB{
+		ResourceDefBase: &ResourceDefBase{TypeName: "EnhancedLoadBalancer", DefName: "elb"},
+		Selector:        &ResourceSelector{Names: []string{"test-monitoring-target-elb"}},
+	}
+
+	tests := []struct {
+		name         string
+		defs         ResourceDefinitions
+		resourceName string
+		want         []ResourceTypes
+		wantErr      bool
+	}{
+		{
+			name:         "server with parent ELB",
+			defs:         ResourceDefinitions{serverDef, elbDef},
+			resourceName: "server",
+			want:         []ResourceTypes{ResourceTypeServer, ResourceTypeELB},
+		},
+		{
+			name:         "ELB",
+			defs:         ResourceDefinitions{serverDef, elbDef},
+			resourceName: "elb",
+			want:         []ResourceTypes{ResourceTypeELB},
+		},
+		{
+			name:         "default with a definition",
+			defs:         ResourceDefinitions{elbDef},
+			resourceName: "default",
+			want:         []ResourceTypes{ResourceTypeELB},
+		},
+		{
+			name:         "default with multiple definitions",
+			defs:         ResourceDefinitions{serverDef, elbDef},
+			resourceName: "default",
+			wantErr:      true,
+		},
+		{
+			name:         "not found",
+			defs:         ResourceDefinitions{serverDef, elbDef},
+			resourceName: "not-exists",
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			targets, err := tt.defs.MonitoringTargets(context.Background(), test.APIClient, tt.resourceName)
+			require.Equal(t, tt.wantErr, err != nil, err)
+
+			var got []ResourceTypes
+			for _, target := range targets {
+				got = append(got, target.Type)
+				if target.Type == ResourceTypeServer {
+					require.Equal(t, server.ID, target.ID)
+					require.Equal(t, test.Zone, target.Zone)
+				}
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -14,7 +14,10 @@
 
 package core
 
-import "github.com/sacloud/iaas-api-go"
+import (
+	"github.com/sacloud/autoscaler/request"
+	"github.com/sacloud/iaas-api-go"
+)
 
 type ELBPlan struct {
 	Name string `yaml:"name"`
@@ -46,3 +49,7 @@ func (p *ELBPlan) LessThanPlan(plan ResourcePlan) bool {
 	}
 	return p.CPS < elbPlan.CPS
 }
+
+func (p *ELBPlan) MatchDesiredSpec(spec *request.DesiredSpec) bool {
+	return int(spec.Cps) == p.CPS
+}
@@ -14,7 +14,10 @@
 
 package core
 
-import "github.com/sacloud/iaas-api-go"
+import (
+	"github.com/sacloud/autoscaler/request"
+	"github.com/sacloud/iaas-api-go"
+)
 
 type RouterPlan struct {
 	Name      string `yaml:"name"`
@@ -46,3 +49,7 @@ func (p *RouterPlan) LessThanPlan(plan ResourcePlan) bool {
 	}
 	return p.BandWidth < elbPlan.BandWidth
 }
+
+func (p *RouterPlan) MatchDesiredSpec(spec *request.DesiredSpec) bool {
+	return int(spec.BandWidth) == p.BandWidth
+}
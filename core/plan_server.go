@@ -14,7 +14,10 @@
 
 package core
 
-import "github.com/sacloud/iaas-api-go"
+import (
+	"github.com/sacloud/autoscaler/request"
+	"github.com/sacloud/iaas-api-go"
+)
 
 type ServerPlan struct {
 	Name   string `yaml:"name"`
@@ -53,3 +56,11 @@ func (p *ServerPlan) LessThanPlan(plan ResourcePlan) bool {
 	}
 	return p.Memory < serverPlan.Memory
 }
+
+// MatchDesiredSpec ResourcePlan.MatchDesiredSpecの実装
+//
+// GPUやCPUモデル、専有割り当ての有無はリソース定義単位で共通のためコア数とメモリサイズのみを比較する。
+// 同じコア数/メモリサイズを持つプランはValidateで拒否される
+func (p *ServerPlan) MatchDesiredSpec(spec *request.DesiredSpec) bool {
+	return int(spec.Core) == p.Core && int(spec.Memory) == p.Memory
+}
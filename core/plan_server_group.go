@@ -14,6 +14,8 @@
 
 package core
 
+import "github.com/sacloud/autoscaler/request"
+
 type ServerGroupPlan struct {
 	Name string `yaml:"name"`
 	Size int    `yaml:"size"`
@@ -44,3 +46,7 @@ func (p *ServerGroupPlan) LessThanPlan(plan ResourcePlan) bool {
 	}
 	return p.Size < sgPlan.Size
 }
+
+func (p *ServerGroupPlan) MatchDesiredSpec(spec *request.DesiredSpec) bool {
+	return int(spec.Size) == p.Size
+}
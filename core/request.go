@@ -14,7 +14,12 @@
 
 package core
 
-import "fmt"
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/sacloud/autoscaler/request"
+)
 
 type RequestTypes int
 
@@ -23,6 +28,8 @@ const (
 	requestTypeUp                   // スケールアップ or スケールアウト
 	requestTypeDown                 // スケールダウン or スケールイン
 	requestTypeKeep                 // 台数維持
+	requestTypeRefresh              // ServerGroupのインスタンスリフレッシュ
+	requestTypeAdopt                // 既存サーバのServerGroupへの取り込み
 )
 
 func (r RequestTypes) String() string {
@@ -33,6 +40,10 @@ func (r RequestTypes) String() string {
 		return "Down"
 	case requestTypeKeep:
 		return "Keep"
+	case requestTypeRefresh:
+		return "Refresh"
+	case requestTypeAdopt:
+		return "Adopt"
 	default:
 		return "unknown request type"
 	}
@@ -44,6 +55,22 @@ type requestInfo struct {
 	resourceName     string
 	desiredStateName string
 	sync             bool
+	step             uint32
+	labels           map[string]string
+	payloadRef       string
+	desiredSpec      *request.DesiredSpec
+}
+
+// metadataAttrs ログ出力用に、Inputsから引き渡されたラベルとペイロードへの参照を返す
+func (r *requestInfo) metadataAttrs() []any {
+	var attrs []any
+	if len(r.labels) > 0 {
+		attrs = append(attrs, slog.Any("labels", r.labels))
+	}
+	if r.payloadRef != "" {
+		attrs = append(attrs, slog.String("payload-ref", r.payloadRef))
+	}
+	return attrs
 }
 
 func (r *requestInfo) String() string {
@@ -65,6 +65,10 @@ func (c *RequestContext) WithJobStatus(job *JobStatus) *RequestContext {
 			resourceName:     c.request.resourceName,
 			desiredStateName: c.request.desiredStateName,
 			sync:             c.request.sync,
+			step:             c.request.step,
+			labels:           c.request.labels,
+			payloadRef:       c.request.payloadRef,
+			desiredSpec:      c.request.desiredSpec,
 		},
 		logger: c.logger,
 		job:    job,
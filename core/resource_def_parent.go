@@ -31,6 +31,11 @@ import (
 type ParentResourceDef struct {
 	TypeName string          `yaml:"type" validate:"required,oneof=DNS EnhancedLoadBalancer ELB GSLB LoadBalancer"`
 	Selector *NameOrSelector `yaml:"selector" validate:"required"`
+
+	// DrainTimeout 配下のサーバを切り離す際、無効化してから実際に切り離す(削除/シャットダウンする)までに接続のドレインを待つ秒数
+	//
+	// ELB/LoadBalancerの場合はアクティブな接続数が0になった時点で待機を終了する
+	DrainTimeout int `yaml:"drain_timeout" validate:"omitempty,min=0,max=3600"`
 }
 
 func (d *ParentResourceDef) Type() ResourceTypes {
@@ -70,6 +75,9 @@ func (d *ParentResourceDef) Validate(ctx context.Context, apiClient iaas.APICall
 	if _, err := d.findCloudResources(ctx, apiClient, zone); err != nil {
 		errors = multierror.Append(errors, err)
 	}
+	if d.DrainTimeout > 0 && d.Type() == ResourceTypeDNS {
+		errors = multierror.Append(errors, validate.Errorf("drain_timeout: cannot be specified with DNS"))
+	}
 
 	// set prefix
 	errors = multierror.Prefix(errors, fmt.Sprintf("resource=%s", d.Type().String())).(*multierror.Error)
@@ -82,6 +82,19 @@ func (d *ResourceDefServer) validatePlans(ctx context.Context, apiClient iaas.AP
 		}
 
 		errors := &multierror.Error{}
+
+		// for unique check: core/memory
+		// DesiredSpecはコア数/メモリサイズでプランを特定するため、同じ値を持つプランは許可しない
+		// (GPUやCPUモデル、専有割り当ての有無はリソース定義単位で共通)
+		specs := map[[2]int]struct{}{}
+		for _, p := range d.Plans {
+			spec := [2]int{p.Core, p.Memory}
+			if _, ok := specs[spec]; ok {
+				errors = multierror.Append(errors, validate.Errorf("plan{core:%d, memory:%d} is duplicated", p.Core, p.Memory))
+			}
+			specs[spec] = struct{}{}
+		}
+
 		for _, zone := range d.Selector.Zones {
 			availablePlans, err := iaas.NewServerPlanOp(apiClient).Find(ctx, zone, nil)
 			if err != nil {
@@ -17,6 +17,7 @@ package core
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"sort"
 	"strings"
 	"time"
@@ -43,6 +44,16 @@ type ResourceDefServerGroup struct {
 	MaxSize int `yaml:"max_size" validate:"min=0,gtecsfield=MinSize"`
 
 	AutoHealing *AutoHealing `yaml:"auto_healing"`
+	ZoneBalance *ZoneBalance `yaml:"zone_balance"`
+	// ScaleInPolicy スケールイン時に削除するサーバの選び方、未指定の場合はZoneBalanceの有無により決定される
+	ScaleInPolicy string       `yaml:"scale_in_policy" validate:"omitempty,oneof=default newest oldest unhealthy-first zone-balanced"`
+	IdleTimeout   *IdleTimeout `yaml:"idle_timeout"`
+
+	InstanceRefresh *InstanceRefresh `yaml:"instance_refresh"`
+	DriftDetection  *DriftDetection  `yaml:"drift_detection"`
+	WarmPool        *WarmPool        `yaml:"warm_pool"`
+	LifecycleHooks  *LifecycleHooks  `yaml:"lifecycle_hooks"`
+	Adoption        *Adoption        `yaml:"adoption"`
 
 	Plans []*ServerGroupPlan `yaml:"plans"`
 
@@ -72,6 +83,9 @@ func (d *ResourceDefServerGroup) Validate(ctx context.Context, apiClient iaas.AP
 	if err := d.printWarningForServerNamePrefix(ctx); err != nil {
 		errors = multierror.Append(errors, err)
 	}
+	if err := d.validateServerNameFormat(); err != nil {
+		errors = multierror.Append(errors, err)
+	}
 
 	if d.Zone != "" && len(d.Zones) > 0 {
 		errors = multierror.Append(errors, validate.Errorf("only one of zone and zones can be specified"))
@@ -88,6 +102,24 @@ func (d *ResourceDefServerGroup) Validate(ctx context.Context, apiClient iaas.AP
 		}
 	}
 
+	if d.IdleTimeout.enabled() && d.MinSize != 0 {
+		errors = multierror.Append(errors, validate.Errorf("idle_timeout: min_size must be 0 when idle_timeout is enabled"))
+	}
+
+	if d.WarmPool.enabled() {
+		for _, nic := range d.Template.NetworkInterfaces {
+			// プールのサーバは作成時点ではインデックスが決まらないため、インデックスから算出するIPアドレスは利用できない
+			if nic.AssignCidrBlock != "" {
+				errors = multierror.Append(errors, validate.Errorf("warm_pool: assign_cidr_block cannot be specified when warm_pool is enabled"))
+				break
+			}
+		}
+	}
+
+	if d.Adoption != nil {
+		errors = multierror.Append(errors, d.Adoption.Validate()...)
+	}
+
 	for _, p := range d.Plans {
 		if !(d.MinSize <= p.Size && p.Size <= d.MaxSize) {
 			errors = multierror.Append(errors, validate.Errorf("plan: plan.size must be between min_size and max_size: size:%d", p.Size))
@@ -132,16 +164,25 @@ func (d *ResourceDefServerGroup) resourcePlans() ResourcePlans {
 
 func (d *ResourceDefServerGroup) Compute(ctx *RequestContext, apiClient iaas.APICaller) (Resources, error) {
 	// 現在のリソースを取得
-	cloudResources, err := d.findCloudResources(ctx, apiClient)
+	servers, err := d.findAllCloudResources(ctx, apiClient)
 	if err != nil {
 		return nil, err
 	}
+	cloudResources, pooled := splitPooledServers(servers)
 
 	switch ctx.Request().requestType {
 	case requestTypeKeep:
-		return d.buildInstancesForKeep(ctx, apiClient, cloudResources)
+		resources, err := d.buildInstancesForKeep(ctx, apiClient, cloudResources)
+		if err != nil || len(resources) > 0 {
+			return resources, err
+		}
+		return d.buildInstancesForWarmPool(ctx, apiClient, pooled)
+	case requestTypeRefresh:
+		return d.buildInstancesForRefreshRequest(ctx, apiClient, cloudResources)
+	case requestTypeAdopt:
+		return d.buildInstancesForAdoption(ctx, apiClient, servers)
 	default:
-		return d.buildInstancesForScaling(ctx, apiClient, cloudResources)
+		return d.buildInstancesForScaling(ctx, apiClient, cloudResources, pooled)
 	}
 }
 
@@ -159,7 +200,7 @@ func (d *ResourceDefServerGroup) computeParent(ctx *RequestContext, apiClient ia
 	return nil, nil
 }
 
-func (d *ResourceDefServerGroup) buildInstancesForScaling(ctx *RequestContext, apiClient iaas.APICaller, cloudResources []*iaas.Server) (Resources, error) {
+func (d *ResourceDefServerGroup) buildInstancesForScaling(ctx *RequestContext, apiClient iaas.APICaller, cloudResources []*iaas.Server, pooled []*iaas.Server) (Resources, error) {
 	// Min/MaxとUp/Downを考慮してサーバ数を決定
 	plan, err := d.desiredPlan(ctx, len(cloudResources))
 	if err != nil {
@@ -175,29 +216,52 @@ func (d *ResourceDefServerGroup) buildInstancesForScaling(ctx *RequestContext, a
 	for i := range cloudResources {
 		resource := d.createResourceFromServer(apiClient, parent, cloudResources[i])
 		resource.indexInGroup = d.resourceIndex(resource)
-		if i >= plan.Size {
-			resource.instruction = handler.ResourceInstructions_DELETE
-		}
 		resources = append(resources, resource)
 	}
+	if len(resources) > plan.Size {
+		if err := d.markForScaleIn(ctx, resources, len(resources)-plan.Size); err != nil {
+			return nil, err
+		}
+	}
 
 	iconId, err := d.Template.FindIconId(ctx, apiClient)
 	if err != nil {
 		return nil, err
 	}
 
+	pooledUsed := false
 	for len(resources) < plan.Size {
-		zone := d.determineZone(len(resources))
+		zone := d.zoneForNewServer(zoneCountsOf(resources), len(resources))
 		ctx := ctx.WithZone(zone)
 
 		serverName, index := d.determineServerName(resources)
 
+		// ウォームプールにサーバがあれば新規作成の代わりに起動する
+		if server := takePooledServer(&pooled, zone); server != nil {
+			resource, err := d.createResourceFromPool(ctx, apiClient, parent, iconId, server, serverName, index)
+			if err != nil {
+				return nil, err
+			}
+			resources = append(resources, resource)
+			pooledUsed = true
+			continue
+		}
+
 		resource, err := d.createResourceWithCreateInstruction(ctx, apiClient, parent, iconId, zone, serverName, index)
 		if err != nil {
 			return nil, err
 		}
 		resources = append(resources, resource)
 	}
+
+	// プールから取り出した分はスケールアウト後に補充する
+	if pooledUsed {
+		refill, err := d.buildInstancesForWarmPool(ctx, apiClient, pooled)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, refill...)
+	}
 	return resources, nil
 }
 
@@ -227,9 +291,20 @@ func (d *ResourceDefServerGroup) createResourceWithCreateInstruction(ctx *Reques
 		return nil, err
 	}
 
-	commitment := types.Commitments.Standard
-	if d.Template.Plan.DedicatedCPU {
-		commitment = types.Commitments.DedicatedCPU
+	plan, fallbackPlans, err := d.Template.SelectPlan(ctx, apiClient, zone)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := d.tagsForNewServer(ctx, apiClient, zone, index)
+	if err != nil {
+		return nil, err
+	}
+	if d.Template.hasFallbackPlans() {
+		tags = append(tags, plan.tag())
+		if plan != d.Template.plansForZone(zone)[0] {
+			ctx.Logger().Info("fallback plan selected", slog.String("server", name), slog.String("zone", zone), slog.String("plan", plan.String()))
+		}
 	}
 
 	return &ResourceServerGroupInstance{
@@ -240,34 +315,75 @@ func (d *ResourceDefServerGroup) createResourceWithCreateInstruction(ctx *Reques
 		apiClient: apiClient,
 		server: &iaas.Server{
 			Name:            name,
-			Tags:            d.Template.CalculateTagsByIndex(index, len(d.Zones)),
+			Tags:            tags,
 			Description:     d.Template.Description,
 			IconID:          types.StringID(iconId),
 			CDROMID:         types.StringID(cdromId),
 			PrivateHostID:   types.StringID(privateHostId),
 			InterfaceDriver: d.Template.InterfaceDriver,
-			CPU:             d.Template.Plan.Core,
-			MemoryMB:        d.Template.Plan.Memory * size.GiB,
-			GPU:             d.Template.Plan.GPU,
-			CPUModel:        d.Template.Plan.CPUModel,
-			Commitment:      commitment,
+			CPU:             plan.Core,
+			MemoryMB:        plan.Memory * size.GiB,
+			GPU:             plan.GPU,
+			CPUModel:        plan.CPUModel,
+			Commitment:      boolToCommitment(plan.DedicatedCPU),
 		},
-		zone:         zone,
-		def:          d,
-		instruction:  handler.ResourceInstructions_CREATE,
-		indexInGroup: index,
-		parent:       parent,
+		zone:          zone,
+		def:           d,
+		instruction:   handler.ResourceInstructions_CREATE,
+		indexInGroup:  index,
+		fallbackPlans: fallbackPlans,
+		parent:        parent,
 	}, nil
 }
 
 func (d *ResourceDefServerGroup) buildInstancesForKeep(ctx *RequestContext, apiClient iaas.APICaller, cloudResources []*iaas.Server) (Resources, error) {
-	if d.AutoHealing == nil || !d.AutoHealing.Enabled {
+	autoHealing := d.AutoHealing != nil && d.AutoHealing.Enabled
+	if !autoHealing && !d.ZoneBalance.rebalance() && !d.InstanceRefresh.onKeep() {
 		return nil, nil
 	}
 
-	// サーバ名から現在のサーバグループの台数を把握
-	count := d.sizeByMaxIndex(cloudResources)
+	parent, err := d.computeParent(ctx, apiClient)
+	if err != nil {
+		return nil, err
+	}
+	iconId, err := d.Template.FindIconId(ctx, apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	var existing Resources
+	for _, server := range cloudResources {
+		resource := d.createResourceFromServer(apiClient, parent, server)
+		resource.indexInGroup = d.resourceIndex(resource)
+		existing = append(existing, resource)
+	}
+
+	if autoHealing {
+		resources, err := d.buildInstancesForHealing(ctx, apiClient, parent, iconId, cloudResources, zoneCountsOf(existing))
+		if err != nil {
+			return nil, err
+		}
+		// 自動復旧を行う場合は再配置や入れ替えは次回以降に持ち越す
+		if len(resources) > 0 {
+			return resources, nil
+		}
+	}
+	resources, err := d.buildInstancesForRebalance(ctx, apiClient, parent, iconId, existing)
+	if err != nil {
+		return nil, err
+	}
+	if len(resources) > 0 || !d.InstanceRefresh.onKeep() {
+		return resources, nil
+	}
+	// Keep時はフィンガープリントが付与されていないサーバ(機能を有効にする前に作成されたサーバ)は入れ替えない
+	return d.buildInstancesForRefresh(ctx, apiClient, parent, iconId, existing, false)
+}
 
+// buildInstancesForRefreshRequest Refreshリクエスト時に入れ替えるべきリソースを返す
+func (d *ResourceDefServerGroup) buildInstancesForRefreshRequest(ctx *RequestContext, apiClient iaas.APICaller, cloudResources []*iaas.Server) (Resources, error) {
+	if !d.InstanceRefresh.enabled() {
+		return nil, fmt.Errorf("instance_refresh is not enabled: %s", d.Name())
+	}
 	parent, err := d.computeParent(ctx, apiClient)
 	if err != nil {
 		return nil, err
@@ -277,8 +393,23 @@ func (d *ResourceDefServerGroup) buildInstancesForKeep(ctx *RequestContext, apiC
 		return nil, err
 	}
 
+	var existing Resources
+	for _, server := range cloudResources {
+		resource := d.createResourceFromServer(apiClient, parent, server)
+		resource.indexInGroup = d.resourceIndex(resource)
+		existing = append(existing, resource)
+	}
+	return d.buildInstancesForRefresh(ctx, apiClient, parent, iconId, existing, true)
+}
+
+// buildInstancesForHealing ヘルスチェックに失敗したサーバや欠けているサーバを再作成するためのリソースを返す
+func (d *ResourceDefServerGroup) buildInstancesForHealing(ctx *RequestContext, apiClient iaas.APICaller, parent Resource, iconId string, cloudResources []*iaas.Server, counts zoneCounts) (Resources, error) {
+	// サーバ名から現在のサーバグループの台数を把握
+	size := d.healingSize(cloudResources)
+	count := d.countByIndex(cloudResources)
+
 	var resources Resources
-	for i := 0; i < count; i++ {
+	for i := 0; i < d.MaxSize; i++ {
 		name := d.serverNameByIndex(i)
 		server := d.findCloudResourceByName(cloudResources, name)
 
@@ -304,10 +435,17 @@ func (d *ResourceDefServerGroup) buildInstancesForKeep(ctx *RequestContext, apiC
 
 			// healthyではない場合は一度消すために操作対象リソースとしてセットしておく
 			resources = append(resources, resource)
+			counts[zone]--
+		} else {
+			// 欠けているサーバは存在すべき台数に達するまで小さいインデックスから補充する
+			if count >= size {
+				continue
+			}
+			count++
 		}
 
-		// 新たにサーバ作成する際はインデックスからゾーンを再計算
-		zone = d.determineZone(i)
+		// 新たにサーバ作成する際はゾーンを再計算
+		zone = d.zoneForNewServer(counts, i)
 		ctx := ctx.WithZone(zone)
 
 		resource, err := d.createResourceWithCreateInstruction(ctx, apiClient, parent, iconId, zone, d.serverNameByIndex(i), i)
@@ -315,11 +453,44 @@ func (d *ResourceDefServerGroup) buildInstancesForKeep(ctx *RequestContext, apiC
 			return nil, err
 		}
 		resources = append(resources, resource)
+		counts[zone]++
 	}
 
 	return resources, nil
 }
 
+// buildInstancesForRebalance ゾーン間の台数の偏りを解消するためのリソースを返す
+//
+// 台数を減らさないように移動先ゾーンでのサーバ作成を先に行い、その後で移動元のサーバを削除する。
+// 一度に移動するのは1台のみ
+func (d *ResourceDefServerGroup) buildInstancesForRebalance(ctx *RequestContext, apiClient iaas.APICaller, parent Resource, iconId string, existing Resources) (Resources, error) {
+	target, zone := d.rebalanceTarget(existing)
+	if target == nil {
+		return nil, nil
+	}
+
+	serverName, index := d.determineServerName(existing)
+	if index >= d.MaxSize {
+		ctx.Logger().Info("rebalancing skipped: no available server index within max_size",
+			slog.String("from", target.zone),
+			slog.String("to", zone),
+		)
+		return nil, nil
+	}
+	ctx.Logger().Info("rebalancing zones",
+		slog.String("server", target.server.Name),
+		slog.String("from", target.zone),
+		slog.String("to", zone),
+	)
+
+	created, err := d.createResourceWithCreateInstruction(ctx.WithZone(zone), apiClient, parent, iconId, zone, serverName, index)
+	if err != nil {
+		return nil, err
+	}
+	target.instruction = handler.ResourceInstructions_DELETE
+	return Resources{created, target}, nil
+}
+
 func (d *ResourceDefServerGroup) desiredPlan(ctx *RequestContext, currentCount int) (*ServerGroupPlan, error) {
 	if ctx.Request().resourceName != d.Name() {
 		return &ServerGroupPlan{Size: currentCount}, nil
@@ -349,11 +520,58 @@ func (d *ResourceDefServerGroup) resourceIndex(resource Resource) int {
 }
 
 func (d *ResourceDefServerGroup) serverNameByIndex(index int) string {
+	return d.serverNameWithPrefix(d.namePrefix(), index)
+}
+
+func (d *ResourceDefServerGroup) serverNameWithPrefix(prefix string, index int) string {
 	nameFormat := d.ServerNameFormat
+	if isGoTemplate(nameFormat) {
+		name, err := d.renderServerName(prefix, index)
+		if err == nil {
+			return name
+		}
+		// Validateで検証済みのため通常ここには到達しない
+		nameFormat = ""
+	}
 	if nameFormat == "" {
 		nameFormat = "%s-%03d"
 	}
-	return fmt.Sprintf(nameFormat, d.namePrefix(), index+1)
+	return fmt.Sprintf(nameFormat, prefix, index+1)
+}
+
+// renderServerName server_name_formatをGoテンプレートとして評価しサーバ名を算出する
+func (d *ResourceDefServerGroup) renderServerName(prefix string, index int) (string, error) {
+	return renderTemplate("server_name_format", d.ServerNameFormat, &ServerGroupNameTemplateData{
+		Prefix:    prefix,
+		GroupName: d.Name(),
+		Index:     index,
+		Number:    index + 1,
+	})
+}
+
+// validateServerNameFormat server_name_formatをGoテンプレートとして評価できるか検証する
+//
+// サーバ名はグループ内のサーバの識別に用いるため、プレフィックスから始まりインデックスごとに異なる必要がある
+func (d *ResourceDefServerGroup) validateServerNameFormat() error {
+	if !isGoTemplate(d.ServerNameFormat) {
+		return nil
+	}
+	prefix := d.namePrefix()
+	first, err := d.renderServerName(prefix, 0)
+	if err != nil {
+		return validate.Errorf("invalid server_name_format: %s", err)
+	}
+	second, err := d.renderServerName(prefix, 1)
+	if err != nil {
+		return validate.Errorf("invalid server_name_format: %s", err)
+	}
+	if !strings.HasPrefix(first, prefix) {
+		return validate.Errorf("server_name_format: server name must start with %q: %s", prefix, first)
+	}
+	if first == second {
+		return validate.Errorf("server_name_format: server name must contain the index: %s", first)
+	}
+	return nil
 }
 
 // determineServerName resourcesから次に追加すべきサーバの名前を決定する
@@ -376,6 +594,11 @@ func (d *ResourceDefServerGroup) determineServerName(resources Resources) (strin
 	return d.serverNameByIndex(len(resources)), len(resources)
 }
 
+// allowsIndexGaps スケールインやインスタンスリフレッシュによりサーバ名のインデックスに途中抜けが生じうる場合trueを返す
+func (d *ResourceDefServerGroup) allowsIndexGaps() bool {
+	return d.scaleInCreatesIndexGaps() || d.InstanceRefresh.createsIndexGaps()
+}
+
 // sizeByMaxIndex 渡されたサーバの名前からサーバグループに何台のサーバが存在すべきかを計算する
 //
 // Examples:
@@ -410,7 +633,7 @@ func (d *ResourceDefServerGroup) sizeByMaxIndex(servers []*iaas.Server) int {
 }
 
 // determineZone サーバのインデックスからサーバを配置すべきゾーンを決定する
-// 現在はインデックスのみ考慮しており、特定ゾーンへのサーバの偏りがあっても考慮されない
+// インデックスのみ考慮しており、特定ゾーンへのサーバの偏りがあっても考慮されない(偏りを考慮する場合はZoneBalanceを利用する)
 // d.Zonesが空だとpanicする
 func (d *ResourceDefServerGroup) determineZone(index int) string {
 	switch len(d.Zones) {
@@ -423,7 +646,18 @@ func (d *ResourceDefServerGroup) determineZone(index int) string {
 	}
 }
 
+// findCloudResources サーバグループのサーバを返す、ウォームプールのサーバは含まない
 func (d *ResourceDefServerGroup) findCloudResources(ctx context.Context, apiClient iaas.APICaller) ([]*iaas.Server, error) {
+	servers, err := d.findAllCloudResources(ctx, apiClient)
+	if err != nil {
+		return nil, err
+	}
+	active, _ := splitPooledServers(servers)
+	return active, nil
+}
+
+// findAllCloudResources ウォームプールのサーバを含むサーバグループの全てのサーバを返す
+func (d *ResourceDefServerGroup) findAllCloudResources(ctx context.Context, apiClient iaas.APICaller) ([]*iaas.Server, error) {
 	serverOp := iaas.NewServerOp(apiClient)
 	selector := &ResourceSelector{Names: []string{d.namePrefix()}}
 
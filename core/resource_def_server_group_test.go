@@ -25,6 +25,7 @@ import (
 	"github.com/sacloud/autoscaler/config"
 	"github.com/sacloud/autoscaler/handler"
 	"github.com/sacloud/autoscaler/log"
+	"github.com/sacloud/autoscaler/request"
 	"github.com/sacloud/autoscaler/test"
 	"github.com/sacloud/autoscaler/validate"
 	"github.com/sacloud/iaas-api-go"
@@ -610,6 +611,87 @@ func TestResourceDefServerGroup_Validate(t *testing.T) {
 				fmt.Errorf("max_size: gtecsfield=MinSize"),
 			},
 		},
+		{
+			name: "returns error with idle_timeout and non-zero min_size",
+			def: &ResourceDefServerGroup{
+				ResourceDefBase: &ResourceDefBase{
+					TypeName: "ServerGroup",
+					DefName:  "test",
+				},
+				Zones:       []string{"is1a"},
+				MinSize:     1,
+				MaxSize:     2,
+				IdleTimeout: &IdleTimeout{Enabled: true},
+				Template: &ServerGroupInstanceTemplate{
+					Plan: &ServerGroupInstancePlan{
+						Core:   1,
+						Memory: 1,
+					},
+				},
+			},
+			want: []error{
+				fmt.Errorf("resource=ServerGroup idle_timeout: min_size must be 0 when idle_timeout is enabled"),
+			},
+		},
+		{
+			name: "returns error with warm_pool and assign_cidr_block",
+			def: &ResourceDefServerGroup{
+				ResourceDefBase: &ResourceDefBase{
+					TypeName: "ServerGroup",
+					DefName:  "test",
+				},
+				Zones:    []string{"is1a"},
+				MinSize:  1,
+				MaxSize:  2,
+				WarmPool: &WarmPool{Size: 1},
+				Template: &ServerGroupInstanceTemplate{
+					Plan: &ServerGroupInstancePlan{
+						Core:   1,
+						Memory: 1,
+					},
+					NetworkInterfaces: []*ServerGroupNICTemplate{
+						{
+							Upstream:        &ServerGroupNICUpstream{selector: &ResourceSelector{Names: []string{"test"}}},
+							AssignCidrBlock: "192.168.0.0/24",
+						},
+					},
+				},
+			},
+			want: []error{
+				fmt.Errorf("resource=ServerGroup warm_pool: assign_cidr_block cannot be specified when warm_pool is enabled"),
+			},
+		},
+		{
+			name: "returns error with warm_pool and assign_cidr_block on secondary NIC",
+			def: &ResourceDefServerGroup{
+				ResourceDefBase: &ResourceDefBase{
+					TypeName: "ServerGroup",
+					DefName:  "test",
+				},
+				Zones:    []string{"is1a"},
+				MinSize:  1,
+				MaxSize:  2,
+				WarmPool: &WarmPool{Size: 1},
+				Template: &ServerGroupInstanceTemplate{
+					Plan: &ServerGroupInstancePlan{
+						Core:   1,
+						Memory: 1,
+					},
+					NetworkInterfaces: []*ServerGroupNICTemplate{
+						{
+							Upstream: &ServerGroupNICUpstream{shared: true},
+						},
+						{
+							Upstream:        &ServerGroupNICUpstream{selector: &ResourceSelector{Names: []string{"test"}}},
+							AssignCidrBlock: "192.168.0.0/24",
+						},
+					},
+				},
+			},
+			want: []error{
+				fmt.Errorf("resource=ServerGroup warm_pool: assign_cidr_block cannot be specified when warm_pool is enabled"),
+			},
+		},
 		{
 			name: "returns no error without server_name_prefix",
 			def: &ResourceDefServerGroup{
@@ -1114,6 +1196,47 @@ func TestResourceDefServerGroup_desiredPlan(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "up with desired spec",
+			fields: fields{
+				MinSize: 1,
+				MaxSize: 10,
+				Plans:   nil,
+			},
+			args: args{
+				ctx: NewRequestContext(context.Background(), &requestInfo{
+					requestType:  requestTypeUp,
+					source:       "default",
+					resourceName: "default",
+					desiredSpec:  &request.DesiredSpec{Size: 7},
+				}, test.Logger),
+				currentCount: 2,
+			},
+			want: &ServerGroupPlan{
+				Name: "",
+				Size: 7,
+			},
+			wantErr: false,
+		},
+		{
+			name: "up with desired spec larger than max_size",
+			fields: fields{
+				MinSize: 1,
+				MaxSize: 5,
+				Plans:   nil,
+			},
+			args: args{
+				ctx: NewRequestContext(context.Background(), &requestInfo{
+					requestType:  requestTypeUp,
+					source:       "default",
+					resourceName: "default",
+					desiredSpec:  &request.DesiredSpec{Size: 7},
+				}, test.Logger),
+				currentCount: 2,
+			},
+			want:    nil,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
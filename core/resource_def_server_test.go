@@ -46,6 +46,28 @@ func TestResourceDefServer_Validate(t *testing.T) {
 		require.Len(t, errs, 1)
 		require.EqualError(t, errs[0], "resource=Server resource not found with selector: ID: , Names: [server-not-found], Tags: [], Zones: [is1a]")
 	})
+
+	t.Run("returns error if plans have the same spec", func(t *testing.T) {
+		def := &ResourceDefServer{
+			ResourceDefBase: &ResourceDefBase{
+				TypeName: "Server",
+			},
+			Selector: &MultiZoneSelector{
+				ResourceSelector: &ResourceSelector{
+					Names: []string{"test-server"},
+				},
+				Zones: []string{"is1a"},
+			},
+			Plans: []*ServerPlan{
+				{Name: "small", Core: 1, Memory: 1},
+				{Name: "small-alias", Core: 1, Memory: 1},
+				{Name: "large", Core: 2, Memory: 4},
+			},
+		}
+		errs := def.validatePlans(context.Background(), test.APIClient)
+		require.NotEmpty(t, errs)
+		require.ErrorContains(t, errs[0], "plan{core:1, memory:1} is duplicated")
+	})
 }
 
 func TestResourceDefServer_Compute(t *testing.T) {
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-multierror"
+	"github.com/sacloud/autoscaler/handler"
 	"github.com/sacloud/autoscaler/request"
 	"github.com/sacloud/autoscaler/validate"
 	"github.com/sacloud/iaas-api-go"
@@ -156,14 +157,20 @@ func (rds *ResourceDefinitions) handleAll(ctx *RequestContext, apiClient iaas.AP
 	defer rds.startProgressLogger(ctx)()
 
 	for _, def := range defs {
-		resources, err := def.Compute(ctx, apiClient)
-		if err != nil {
-			return err
-		}
-		for _, resource := range resources {
-			if err := rds.handleResource(ctx, handlers, resource); err != nil {
+		for {
+			resources, err := def.Compute(ctx, apiClient)
+			if err != nil {
 				return err
 			}
+			for _, resource := range resources {
+				if err := rds.handleResource(ctx, handlers, resource); err != nil {
+					return err
+				}
+			}
+			// インスタンスリフレッシュは入れ替え対象がなくなるまでバッチ単位で繰り返す
+			if ctx.Request().requestType != requestTypeRefresh || len(resources) == 0 {
+				break
+			}
 		}
 	}
 	return nil
@@ -185,6 +192,17 @@ func (rds *ResourceDefinitions) handleResource(parentCtx *RequestContext, handle
 	}
 	handlingCtx := NewHandlingContext(parentCtx, computed).WithLogger("type", computed.Type(), "zone", zone, "id", id, "name", computed.Name())
 
+	// ServerGroupのライフサイクルフック
+	var hooks *LifecycleHooks
+	if r, ok := resource.(*ResourceServerGroupInstance); ok && !r.pooled {
+		hooks = r.def.LifecycleHooks
+	}
+	if computed.Instruction() == handler.ResourceInstructions_DELETE {
+		if err := hooks.wait(handlingCtx, LifecycleHookTerminating, computed); err != nil {
+			return err
+		}
+	}
+
 	// preHandle
 	if err := rds.handleAllByFunc(computed, handlers, func(h *Handler, c Computed) error {
 		ctx := handlingCtx.WithLogger("step", "PreHandle", "handler", h.Name)
@@ -218,6 +236,11 @@ func (rds *ResourceDefinitions) handleResource(parentCtx *RequestContext, handle
 		id = "(known after handle)"
 	}
 	handlingCtx = NewHandlingContext(parentCtx, computed).WithLogger("type", refreshed.Type(), "zone", zone, "id", id, "name", refreshed.Name())
+	if handlingCtx.ComputeResult(refreshed) == handler.PostHandleRequest_CREATED {
+		if err := hooks.wait(handlingCtx, LifecycleHookLaunching, refreshed); err != nil {
+			return err
+		}
+	}
 	computed = refreshed
 
 	// postHandle
@@ -63,8 +63,9 @@ func (r *ParentResource) Compute(ctx *RequestContext, refresh bool) (Computed, e
 			return nil, fmt.Errorf("computing desired state failed: %s", err)
 		}
 		computed = &computedELB{
-			instruction: handler.ResourceInstructions_NOOP,
-			elb:         v,
+			instruction:  handler.ResourceInstructions_NOOP,
+			elb:          v,
+			drainTimeout: r.def.DrainTimeout,
 		}
 	case ResourceTypeGSLB:
 		v := &iaas.GSLB{}
@@ -72,8 +73,9 @@ func (r *ParentResource) Compute(ctx *RequestContext, refresh bool) (Computed, e
 			return nil, fmt.Errorf("computing desired state failed: %s", err)
 		}
 		computed = &computedGSLB{
-			instruction: handler.ResourceInstructions_NOOP,
-			gslb:        v,
+			instruction:  handler.ResourceInstructions_NOOP,
+			gslb:         v,
+			drainTimeout: r.def.DrainTimeout,
 		}
 	case ResourceTypeDNS:
 		v := &iaas.DNS{}
@@ -100,9 +102,10 @@ func (r *ParentResource) Compute(ctx *RequestContext, refresh bool) (Computed, e
 			return nil, fmt.Errorf("computing desired state failed: %s", err)
 		}
 		computed = &computedLoadBalancer{
-			instruction: handler.ResourceInstructions_NOOP,
-			lb:          v,
-			zone:        r.zone,
+			instruction:  handler.ResourceInstructions_NOOP,
+			lb:           v,
+			zone:         r.zone,
+			drainTimeout: r.def.DrainTimeout,
 		}
 	default:
 		panic("got unexpected type")
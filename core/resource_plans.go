@@ -19,6 +19,7 @@ import (
 	"sort"
 
 	"github.com/sacloud/autoscaler/defaults"
+	"github.com/sacloud/autoscaler/request"
 )
 
 // ResourcePlan オートスケールをサポートするリソースでのプランを表すインターフェース
@@ -31,6 +32,8 @@ type ResourcePlan interface {
 	LessThan(resource interface{}) bool
 	// LessThanPlan 指定のプランが該当プランより小さい値であるかを判定する(境界は含めない)
 	LessThanPlan(plans ResourcePlan) bool
+	// MatchDesiredSpec リクエストで明示的に指定されたスケールが該当プランと同じ値を持っているか判定する
+	MatchDesiredSpec(spec *request.DesiredSpec) bool
 }
 
 // ResourcePlans オートスケールをサポートするリソースでのプラン一覧を表すインターフェース
@@ -143,31 +146,44 @@ func desiredPlan(ctx *RequestContext, current interface{}, plans ResourcePlans)
 
 	req := ctx.Request()
 
-	// DesiredStateNameが指定されていたら該当プランを探す
-	if req.desiredStateName != "" && req.desiredStateName != defaults.DesiredStateName {
-		var found ResourcePlan
+	// DesiredStateNameまたはDesiredSpecが指定されていたら該当プランを探す
+	var found ResourcePlan
+	var target string
+	switch {
+	case req.desiredStateName != "" && req.desiredStateName != defaults.DesiredStateName:
+		target = fmt.Sprintf("%q", req.desiredStateName)
 		for _, plan := range plans {
 			if plan.PlanName() == req.desiredStateName {
 				found = plan
 				break
 			}
 		}
+	case req.desiredSpec != nil:
+		target = fmt.Sprintf("{%s}", req.desiredSpec.String())
+		for _, plan := range plans {
+			if plan.MatchDesiredSpec(req.desiredSpec) {
+				found = plan
+				break
+			}
+		}
+	}
+	if target != "" {
 		if found == nil {
-			return nil, fmt.Errorf("desired plan %q not found: request: %s", req.desiredStateName, req.String())
+			return nil, fmt.Errorf("desired plan %s not found: request: %s", target, req.String())
 		}
 
 		switch req.requestType {
 		case requestTypeUp:
 			// foundとcurrentが同じ場合はOK
 			if found.LessThan(current) {
-				// Upリクエストなのに指定の名前のプランの方が小さいためプラン変更しない
-				return nil, fmt.Errorf("desired plan %q is smaller than current plan", req.desiredStateName)
+				// Upリクエストなのに指定のプランの方が小さいためプラン変更しない
+				return nil, fmt.Errorf("desired plan %s is smaller than current plan", target)
 			}
 		case requestTypeDown:
 			// foundとcurrentが同じ場合はOK
 			if !(found.Equals(current) || found.LessThan(current)) {
-				// Downリクエストなのに指定の名前のプランの方が大きいためプラン変更しない
-				return nil, fmt.Errorf("desired plan %q is larger than current plan", req.desiredStateName)
+				// Downリクエストなのに指定のプランの方が大きいためプラン変更しない
+				return nil, fmt.Errorf("desired plan %s is larger than current plan", target)
 			}
 		default: // requestTypeKeepを含む
 			return nil, nil
@@ -175,15 +191,47 @@ func desiredPlan(ctx *RequestContext, current interface{}, plans ResourcePlans)
 		return found, nil
 	}
 
+	// 1段階目はNext/Prevで決定し、残りの段階数はプラン一覧の中で移動する
+	extraSteps := 0
+	if req.step > 1 {
+		extraSteps = int(req.step) - 1
+	}
+
 	var desired ResourcePlan
 	switch req.requestType {
 	case requestTypeUp:
 		desired = plans.Next(current)
+		desired = plans.advance(desired, extraSteps)
 	case requestTypeDown:
 		desired = plans.Prev(current)
+		desired = plans.advance(desired, -extraSteps)
 	default:
 		return nil, nil // 到達しないはず
 	}
 
 	return desired, nil // nilの場合もあり得る
 }
+
+// advance 指定のプランからn段階(負の場合は小さい方向へ)離れたプランを返す
+//
+// 上限/下限を超える場合は上限/下限のプランを返す。planがnilの場合やnが0の場合はplanをそのまま返す
+func (p *ResourcePlans) advance(plan ResourcePlan, n int) ResourcePlan {
+	plans := *p
+	if plan == nil || n == 0 {
+		return plan
+	}
+	for i := range plans {
+		if plans[i] != plan {
+			continue
+		}
+		j := i + n
+		switch {
+		case j < 0:
+			j = 0
+		case j >= len(plans):
+			j = len(plans) - 1
+		}
+		return plans[j]
+	}
+	return plan
+}
@@ -18,6 +18,8 @@ import (
 	"context"
 	"reflect"
 	"testing"
+
+	"github.com/sacloud/autoscaler/request"
 )
 
 type stubResourcePlan struct {
@@ -52,6 +54,10 @@ func (p *stubResourcePlan) LessThanPlan(plan ResourcePlan) bool {
 	return p.memorySize < target.memorySize
 }
 
+func (p *stubResourcePlan) MatchDesiredSpec(spec *request.DesiredSpec) bool {
+	return int(spec.Memory) == p.memorySize
+}
+
 func Test_desiredPlan(t *testing.T) {
 	type args struct {
 		ctx     *RequestContext
@@ -195,6 +201,64 @@ func Test_desiredPlan(t *testing.T) {
 			want:    &stubResourcePlan{memorySize: 3, name: "named"},
 			wantErr: false,
 		},
+		{
+			name: "Up returns plan matched with desired spec",
+			args: args{
+				ctx: &RequestContext{
+					ctx: context.Background(),
+					request: &requestInfo{
+						requestType: requestTypeUp,
+						desiredSpec: &request.DesiredSpec{Memory: 3},
+					},
+				},
+				current: 1,
+				plans: ResourcePlans{
+					&stubResourcePlan{memorySize: 3},
+					&stubResourcePlan{memorySize: 2},
+					&stubResourcePlan{memorySize: 1},
+				},
+			},
+			want:    &stubResourcePlan{memorySize: 3},
+			wantErr: false,
+		},
+		{
+			name: "Up returns error when desired spec is not in plans",
+			args: args{
+				ctx: &RequestContext{
+					ctx: context.Background(),
+					request: &requestInfo{
+						requestType: requestTypeUp,
+						desiredSpec: &request.DesiredSpec{Memory: 5},
+					},
+				},
+				current: 1,
+				plans: ResourcePlans{
+					&stubResourcePlan{memorySize: 2},
+					&stubResourcePlan{memorySize: 1},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Down returns error when desired spec is larger than current",
+			args: args{
+				ctx: &RequestContext{
+					ctx: context.Background(),
+					request: &requestInfo{
+						requestType: requestTypeDown,
+						desiredSpec: &request.DesiredSpec{Memory: 2},
+					},
+				},
+				current: 1,
+				plans: ResourcePlans{
+					&stubResourcePlan{memorySize: 2},
+					&stubResourcePlan{memorySize: 1},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
 		{
 			name: "Up returns error when greater plan not exists",
 			args: args{
@@ -274,6 +338,88 @@ func Test_desiredPlan(t *testing.T) {
 			want:    nil,
 			wantErr: false,
 		},
+		{
+			name: "Up with step returns plan after the step",
+			args: args{
+				ctx: &RequestContext{
+					ctx: context.Background(),
+					request: &requestInfo{
+						requestType: requestTypeUp,
+						step:        2,
+					},
+				},
+				current: 1,
+				plans: ResourcePlans{
+					&stubResourcePlan{memorySize: 4},
+					&stubResourcePlan{memorySize: 3},
+					&stubResourcePlan{memorySize: 2},
+					&stubResourcePlan{memorySize: 1},
+				},
+			},
+			want:    &stubResourcePlan{memorySize: 3},
+			wantErr: false,
+		},
+		{
+			name: "Up with step returns largest plan if the step exceeds",
+			args: args{
+				ctx: &RequestContext{
+					ctx: context.Background(),
+					request: &requestInfo{
+						requestType: requestTypeUp,
+						step:        10,
+					},
+				},
+				current: 1,
+				plans: ResourcePlans{
+					&stubResourcePlan{memorySize: 3},
+					&stubResourcePlan{memorySize: 2},
+					&stubResourcePlan{memorySize: 1},
+				},
+			},
+			want:    &stubResourcePlan{memorySize: 3},
+			wantErr: false,
+		},
+		{
+			name: "Down with step returns plan before the step",
+			args: args{
+				ctx: &RequestContext{
+					ctx: context.Background(),
+					request: &requestInfo{
+						requestType: requestTypeDown,
+						step:        2,
+					},
+				},
+				current: 4,
+				plans: ResourcePlans{
+					&stubResourcePlan{memorySize: 4},
+					&stubResourcePlan{memorySize: 3},
+					&stubResourcePlan{memorySize: 2},
+					&stubResourcePlan{memorySize: 1},
+				},
+			},
+			want:    &stubResourcePlan{memorySize: 2},
+			wantErr: false,
+		},
+		{
+			name: "Down with step returns smallest plan if the step exceeds",
+			args: args{
+				ctx: &RequestContext{
+					ctx: context.Background(),
+					request: &requestInfo{
+						requestType: requestTypeDown,
+						step:        10,
+					},
+				},
+				current: 3,
+				plans: ResourcePlans{
+					&stubResourcePlan{memorySize: 3},
+					&stubResourcePlan{memorySize: 2},
+					&stubResourcePlan{memorySize: 1},
+				},
+			},
+			want:    &stubResourcePlan{memorySize: 1},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -16,6 +16,7 @@ package core
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/sacloud/autoscaler/handler"
 	"github.com/sacloud/iaas-api-go"
@@ -30,7 +31,10 @@ type ResourceServerGroupInstance struct {
 	zone         string
 	def          *ResourceDefServerGroup
 	instruction  handler.ResourceInstructions
-	indexInGroup int // グループ内でのインデックス、値の算出に用いる
+	indexInGroup int  // グループ内でのインデックス、値の算出に用いる
+	pooled       bool // ウォームプールのサーバの場合true
+
+	fallbackPlans []*ServerGroupInstancePlan // 作成に失敗した場合に試行する代替プラン
 
 	parent Resource
 }
@@ -75,9 +79,12 @@ func (r *ResourceServerGroupInstance) Compute(ctx *RequestContext, refresh bool)
 	if len(nics) > 0 {
 		networkInfo = nics[0].AssignedNetwork
 	}
-	editParameter := r.computeEditParameter(ctx, networkInfo)
+	editParameter, err := r.computeEditParameter(ctx, networkInfo)
+	if err != nil {
+		return nil, err
+	}
 
-	return &computedServerGroupInstance{
+	computed := &computedServerGroupInstance{
 		instruction:       r.instruction,
 		setupGracePeriod:  r.setupGracePeriod,
 		server:            r.server,
@@ -87,8 +94,53 @@ func (r *ResourceServerGroupInstance) Compute(ctx *RequestContext, refresh bool)
 		cloudConfig:       r.def.Template.CloudConfig.String(),
 		networkInterfaces: nics,
 		shutdownForce:     r.def.ShutdownForce,
+		pooled:            r.pooled,
+		fallbackPlans:     r.computeFallbackPlans(),
 		parent:            parentComputed,
-	}, nil
+	}
+	if r.instruction == handler.ResourceInstructions_CREATE {
+		if err := r.renderTemplates(ctx, computed); err != nil {
+			return nil, err
+		}
+	}
+	return computed, nil
+}
+
+// renderTemplates cloud_configとstartup_scriptsをGoテンプレートとして評価し、computedへ反映する
+func (r *ResourceServerGroupInstance) renderTemplates(ctx *RequestContext, computed *computedServerGroupInstance) error {
+	data := r.templateData(ctx, computed)
+
+	cloudConfig, err := r.def.Template.CloudConfig.Render(data)
+	if err != nil {
+		return fmt.Errorf("rendering cloud_config failed: %s", err)
+	}
+	computed.cloudConfig = cloudConfig
+
+	if computed.diskEditParameter != nil {
+		startupScripts, err := r.def.Template.EditParameter.RenderStartupScripts(data)
+		if err != nil {
+			return fmt.Errorf("rendering startup_scripts failed: %s", err)
+		}
+		computed.diskEditParameter.StartupScripts = startupScripts
+	}
+	return nil
+}
+
+func (r *ResourceServerGroupInstance) computeFallbackPlans() []*handler.ServerGroupInstance_Plan {
+	if r.instruction != handler.ResourceInstructions_CREATE {
+		return nil
+	}
+	var plans []*handler.ServerGroupInstance_Plan
+	for _, p := range r.fallbackPlans {
+		plans = append(plans, &handler.ServerGroupInstance_Plan{
+			Core:         uint32(p.Core),
+			Memory:       uint32(p.Memory),
+			DedicatedCpu: p.DedicatedCPU,
+			Gpu:          uint32(p.GPU),
+			CpuModel:     p.CPUModel,
+		})
+	}
+	return plans
 }
 
 func (r *ResourceServerGroupInstance) refresh(ctx *RequestContext) error {
@@ -123,15 +175,54 @@ func (r *ResourceServerGroupInstance) refresh(ctx *RequestContext) error {
 	return nil
 }
 
-func (r *ResourceServerGroupInstance) computeEditParameter(ctx *RequestContext, networkInfo *handler.NetworkInfo) *handler.ServerGroupInstance_EditParameter {
+// templateData cloud_configとstartup_scriptsの評価に用いる値を返す
+//
+// 後方互換のため、評価前のcloud_configとstartup_scriptsを持つハンドラーへ渡す値も参照できるようにする
+func (r *ResourceServerGroupInstance) templateData(ctx *RequestContext, computed *computedServerGroupInstance) *ServerGroupTemplateData {
+	data := &ServerGroupTemplateData{
+		Index:               r.indexInGroup,
+		Number:              r.indexInGroup + 1,
+		Zone:                r.zone,
+		ServerName:          r.server.Name,
+		GroupName:           r.def.Name(),
+		ServerGroupInstance: computed.Desired().GetServerGroupInstance(),
+	}
+	if req := ctx.Request(); req != nil {
+		data.Source = req.source
+	}
+	for _, nic := range computed.networkInterfaces {
+		ip := nic.UserIpAddress
+		if nic.AssignedNetwork != nil && nic.AssignedNetwork.IpAddress != "" {
+			ip = nic.AssignedNetwork.IpAddress
+		}
+		data.IPAddresses = append(data.IPAddresses, ip)
+	}
+	if len(data.IPAddresses) > 0 {
+		data.IPAddress = data.IPAddresses[0]
+	}
+	if parent := computed.parent; parent != nil {
+		data.Parent = &ServerGroupTemplateParent{
+			Type: parent.Type().String(),
+			ID:   parent.ID(),
+			Name: parent.Name(),
+			Zone: parent.Zone(),
+		}
+	}
+	return data
+}
+
+// computeEditParameter ディスクの修正パラメータを返す
+//
+// startup_scriptsは評価前の値となる、評価はrenderTemplatesで行う
+func (r *ResourceServerGroupInstance) computeEditParameter(ctx *RequestContext, networkInfo *handler.NetworkInfo) (*handler.ServerGroupInstance_EditParameter, error) {
 	if r.instruction != handler.ResourceInstructions_CREATE || r.def.Template.EditParameter == nil {
-		return nil
+		return nil, nil
 	}
 
 	tmpl := r.def.Template.EditParameter
 
 	if tmpl.Disabled {
-		return nil
+		return nil, nil
 	}
 
 	if networkInfo == nil {
@@ -155,13 +246,13 @@ func (r *ResourceServerGroupInstance) computeEditParameter(ctx *RequestContext,
 		EnableDhcp:          tmpl.EnableDHCP,
 		ChangePartitionUuid: tmpl.ChangePartitionUUID,
 		SshKeys:             sshKeys,
-		StartupScripts:      startupScripts, // Note: この段階ではGoテンプレートは未評価のまま渡す。
+		StartupScripts:      startupScripts,
 
 		// これらは必要に応じてHandlerが設定する
 		IpAddress:      networkInfo.IpAddress,
 		NetworkMaskLen: networkInfo.Netmask,
 		DefaultRoute:   networkInfo.Gateway,
-	}
+	}, nil
 }
 
 func (r *ResourceServerGroupInstance) computeDisks(ctx *RequestContext) ([]*handler.ServerGroupInstance_Disk, error) {
@@ -324,6 +415,11 @@ func (r *ResourceServerGroupInstance) isHealthy(ctx *RequestContext) (bool, erro
 	return true, nil
 }
 
+// isProtected ScaleInProtectionTagによりスケールインから保護されている場合trueを返す
+func (r *ResourceServerGroupInstance) isProtected() bool {
+	return slices.Contains(r.server.Tags, ScaleInProtectionTag)
+}
+
 func (r *ResourceServerGroupInstance) healthCheckRequests(ctx *RequestContext) ([]*ChildResourceHealthCheckRequest, error) {
 	nics, err := r.computeNetworkInterfaces(ctx)
 	if err != nil {
@@ -14,94 +14,12 @@
 
 package core
 
-import (
-	"context"
-	"fmt"
-	"os"
-	"runtime"
-	"sync"
-	"time"
+import "github.com/sacloud/autoscaler/config"
 
-	client "github.com/sacloud/api-client-go"
-	"github.com/sacloud/autoscaler/validate"
-	"github.com/sacloud/autoscaler/version"
-	"github.com/sacloud/go-otelsetup"
-	"github.com/sacloud/iaas-api-go"
-	"github.com/sacloud/iaas-api-go/defaults"
-	"github.com/sacloud/iaas-api-go/helper/api"
-	sacloudtrace "github.com/sacloud/iaas-api-go/trace/otel"
-	"github.com/sacloud/iaas-api-go/types"
-)
-
-type SakuraCloud struct {
-	Credential `yaml:",inline"`
-	Profile    string `yaml:"profile"`
-
-	strictMode bool
-	apiClient  iaas.APICaller
-	initOnce   sync.Once
-	initError  error
-}
-
-// APIClient シングルトンなAPIクライアントを返す
-func (sc *SakuraCloud) APIClient() iaas.APICaller {
-	sc.initOnce.Do(func() {
-		options := []*api.CallerOptions{
-			api.OptionsFromEnv(),
-		}
-		if !sc.strictMode {
-			opt, err := api.OptionsFromProfile(sc.Profile)
-			if err != nil {
-				sc.initError = err
-				return
-			}
-			options = append(options, opt)
-		}
-
-		options = append(options, &api.CallerOptions{
-			Options: &client.Options{
-				AccessToken:       sc.Token,
-				AccessTokenSecret: sc.Secret,
-				UserAgent: fmt.Sprintf(
-					"sacloud/autoscaler/v%s (%s/%s; +https://github.com/sacloud/autoscaler) %s",
-					version.Version,
-					runtime.GOOS,
-					runtime.GOARCH,
-					os.Getenv("SAKURACLOUD_APPEND_USER_AGENT"),
-				),
-			},
-		})
-		sc.apiClient = api.NewCallerWithOptions(api.MergeOptions(options...))
-		// オートスケールでは時間のかかる状態変更待ち(大きなディスクのコピー待ちなど)はあまりない想定
-		defaults.DefaultStatePollingTimeout = 60 * time.Minute
-
-		// 環境変数OTEL_EXPORTER_OTLP_ENDPOINTが指定されていたらOpenTelemetryによるトレースを有効化
-		if otelsetup.Enabled() {
-			sacloudtrace.Initialize()
-		}
-	})
-	return sc.apiClient
-}
-
-// Validate 有効なAPIキーが指定されており、必要なパーミッションが割り当てられていることを確認する
-func (sc *SakuraCloud) Validate(ctx context.Context) error {
-	apiClient := sc.APIClient()
-	if sc.initError != nil {
-		return fmt.Errorf("initializing API Client failed: %s", sc.initError)
-	}
+// SakuraCloud さくらのクラウドAPIのクレデンシャルやプロファイルの設定
+//
+// Inputsと共有するためconfigパッケージで定義している
+type SakuraCloud = config.SakuraCloud
 
-	authStatus, err := iaas.NewAuthStatusOp(apiClient).Read(ctx)
-	if err != nil {
-		if err, ok := err.(iaas.APIError); ok {
-			return validate.Errorf("reading SAKURA cloud account info failed: %s", err.Message())
-		}
-		return fmt.Errorf("reading SAKURA cloud account info failed: unknown error: %s", err)
-	}
-	if authStatus.Permission != types.Permissions.Create && authStatus.Permission != types.Permissions.Arrange {
-		return validate.Errorf("required permissions have not been assigned. assigned permission: %s", authStatus.Permission)
-	}
-	if len(os.Getenv("SAKURACLOUD_APPEND_USER_AGENT")) > 1024 {
-		return fmt.Errorf("SAKURACLOUD_APPEND_USER_AGENT is too long: max=1024")
-	}
-	return nil
-}
+// Credential さくらのクラウドAPI トークン/シークレット
+type Credential = config.Credential
@@ -0,0 +1,162 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"log/slog"
+	"slices"
+
+	"github.com/sacloud/autoscaler/handler"
+	"github.com/sacloud/iaas-api-go"
+)
+
+const (
+	// ScaleInPolicyDefault インデックスが最も大きい(名前の降順で末尾の)サーバから削除する
+	ScaleInPolicyDefault = "default"
+	// ScaleInPolicyNewest 作成日時が最も新しいサーバから削除する
+	ScaleInPolicyNewest = "newest"
+	// ScaleInPolicyOldest 作成日時が最も古いサーバから削除する
+	ScaleInPolicyOldest = "oldest"
+	// ScaleInPolicyUnhealthyFirst ヘルスチェックに失敗しているサーバから削除する、該当するサーバがない場合はdefaultと同じ
+	ScaleInPolicyUnhealthyFirst = "unhealthy-first"
+	// ScaleInPolicyZoneBalanced 台数が最も多いゾーンの中でインデックスが最も大きいサーバから削除する
+	ScaleInPolicyZoneBalanced = "zone-balanced"
+)
+
+// ScaleInProtectionTag このタグが付与されたサーバはスケールインの対象から除外される
+const ScaleInProtectionTag = "@autoscaler-protect"
+
+// scaleInPolicy 有効なスケールインポリシーを返す
+//
+// scale_in_policyが未指定の場合、ZoneBalanceが有効であればzone-balanced、そうでなければdefaultとなる
+func (d *ResourceDefServerGroup) scaleInPolicy() string {
+	if d.ScaleInPolicy == "" {
+		if d.ZoneBalance.enabled() {
+			return ScaleInPolicyZoneBalanced
+		}
+		return ScaleInPolicyDefault
+	}
+	return d.ScaleInPolicy
+}
+
+// scaleInCreatesIndexGaps スケールインによりサーバ名のインデックスに途中抜けが生じうる場合trueを返す
+func (d *ResourceDefServerGroup) scaleInCreatesIndexGaps() bool {
+	return d.scaleInPolicy() != ScaleInPolicyDefault
+}
+
+// markForScaleIn resourcesのうちn台にscale_in_policyに従って削除を指示する
+//
+// ScaleInProtectionTagが付与されたサーバは対象外とし、削除可能なサーバが足りない場合はn台未満となる。
+// インデックスの途中抜けが許されない場合(default)は、保護されたサーバよりインデックスが小さいサーバも対象外とする
+func (d *ResourceDefServerGroup) markForScaleIn(ctx *RequestContext, resources Resources, n int) error {
+	var candidates []*ResourceServerGroupInstance
+	for _, r := range resources {
+		instance := r.(*ResourceServerGroupInstance)
+		if instance.instruction == handler.ResourceInstructions_DELETE {
+			continue
+		}
+		if instance.isProtected() {
+			if !d.allowsIndexGaps() {
+				candidates = nil
+			}
+			continue
+		}
+		candidates = append(candidates, instance)
+	}
+
+	var unhealthy map[*ResourceServerGroupInstance]bool
+	if d.scaleInPolicy() == ScaleInPolicyUnhealthyFirst {
+		unhealthy = make(map[*ResourceServerGroupInstance]bool)
+		for _, instance := range candidates {
+			healthy, err := instance.isHealthy(ctx.WithZone(instance.zone))
+			if err != nil {
+				return err
+			}
+			unhealthy[instance] = !healthy
+		}
+	}
+
+	for ; n > 0; n-- {
+		if len(candidates) == 0 {
+			ctx.Logger().Warn("scale-in was limited because the remaining servers are protected",
+				slog.String("tag", ScaleInProtectionTag),
+				slog.Int("remaining", n),
+			)
+			return nil
+		}
+		i := d.scaleInVictim(resources, candidates, unhealthy)
+		candidates[i].instruction = handler.ResourceInstructions_DELETE
+		candidates = slices.Delete(candidates, i, i+1)
+	}
+	return nil
+}
+
+// scaleInVictim candidatesのうち次に削除すべきサーバのインデックスを返す
+//
+// candidatesは名前の昇順に並んでいる前提、条件が同じ場合は後ろにあるサーバを優先する
+func (d *ResourceDefServerGroup) scaleInVictim(resources Resources, candidates []*ResourceServerGroupInstance, unhealthy map[*ResourceServerGroupInstance]bool) int {
+	last := len(candidates) - 1
+	switch d.scaleInPolicy() {
+	case ScaleInPolicyNewest:
+		found := last
+		for i := last; i >= 0; i-- {
+			if candidates[i].server.CreatedAt.After(candidates[found].server.CreatedAt) {
+				found = i
+			}
+		}
+		return found
+	case ScaleInPolicyOldest:
+		found := last
+		for i := last; i >= 0; i-- {
+			if candidates[i].server.CreatedAt.Before(candidates[found].server.CreatedAt) {
+				found = i
+			}
+		}
+		return found
+	case ScaleInPolicyUnhealthyFirst:
+		for i := last; i >= 0; i-- {
+			if unhealthy[candidates[i]] {
+				return i
+			}
+		}
+	case ScaleInPolicyZoneBalanced:
+		if i := d.zoneBalancedVictim(resources, candidates); i >= 0 {
+			return i
+		}
+	}
+	return last
+}
+
+// healingSize 自動復旧時にサーバグループに何台のサーバが存在すべきかを計算する
+//
+// スケールインによりインデックスの途中抜けが生じうる場合は途中抜けを欠損とみなさず、
+// 命名規則に沿った現在の台数(min_size未満の場合はmin_size)とする
+func (d *ResourceDefServerGroup) healingSize(servers []*iaas.Server) int {
+	if !d.allowsIndexGaps() {
+		return d.sizeByMaxIndex(servers)
+	}
+	return max(d.countByIndex(servers), d.MinSize)
+}
+
+// countByIndex 渡されたサーバのうちMaxSizeの範囲内で命名規則に沿っているものの台数を返す
+func (d *ResourceDefServerGroup) countByIndex(servers []*iaas.Server) int {
+	count := 0
+	for i := 0; i < d.MaxSize; i++ {
+		if d.findCloudResourceByName(servers, d.serverNameByIndex(i)) != nil {
+			count++
+		}
+	}
+	return count
+}
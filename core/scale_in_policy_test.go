@@ -0,0 +1,171 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sacloud/autoscaler/test"
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/iaas-api-go/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceDefServerGroup_markForScaleIn_scaleInPolicy(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		def   *ResourceDefServerGroup
+		setup func(resources Resources)
+		n     int
+		want  []string
+	}{
+		{
+			name: "default",
+			def:  &ResourceDefServerGroup{Zones: []string{"is1a", "is1b"}},
+			n:    2,
+			want: []string{"test-003", "test-004"},
+		},
+		{
+			name: "zone-balanced",
+			def:  &ResourceDefServerGroup{Zones: []string{"is1a", "is1b"}, ScaleInPolicy: ScaleInPolicyZoneBalanced},
+			n:    2,
+			want: []string{"test-002", "test-003"},
+		},
+		{
+			name: "newest",
+			def:  &ResourceDefServerGroup{Zones: []string{"is1a", "is1b"}, ScaleInPolicy: ScaleInPolicyNewest},
+			setup: func(resources Resources) {
+				testScaleInCreatedAt(resources, base, 2, 0, 3, 1)
+			},
+			n:    2,
+			want: []string{"test-001", "test-003"},
+		},
+		{
+			name: "oldest",
+			def:  &ResourceDefServerGroup{Zones: []string{"is1a", "is1b"}, ScaleInPolicy: ScaleInPolicyOldest},
+			setup: func(resources Resources) {
+				testScaleInCreatedAt(resources, base, 2, 0, 3, 1)
+			},
+			n:    2,
+			want: []string{"test-002", "test-004"},
+		},
+		{
+			name: "unhealthy-first",
+			def:  &ResourceDefServerGroup{Zones: []string{"is1a", "is1b"}, ScaleInPolicy: ScaleInPolicyUnhealthyFirst},
+			setup: func(resources Resources) {
+				resources[0].(*ResourceServerGroupInstance).server.InstanceStatus = types.ServerInstanceStatuses.Down
+			},
+			n:    2,
+			want: []string{"test-001", "test-004"},
+		},
+		{
+			name: "protected servers are skipped",
+			def:  &ResourceDefServerGroup{Zones: []string{"is1a", "is1b"}, ScaleInPolicy: ScaleInPolicyUnhealthyFirst},
+			setup: func(resources Resources) {
+				resources[3].(*ResourceServerGroupInstance).server.Tags = types.Tags{ScaleInProtectionTag}
+			},
+			n:    2,
+			want: []string{"test-002", "test-003"},
+		},
+		{
+			name: "not enough unprotected servers",
+			def:  &ResourceDefServerGroup{Zones: []string{"is1a", "is1b"}, ScaleInPolicy: ScaleInPolicyUnhealthyFirst},
+			setup: func(resources Resources) {
+				for _, r := range resources[1:] {
+					r.(*ResourceServerGroupInstance).server.Tags = types.Tags{ScaleInProtectionTag}
+				}
+			},
+			n:    2,
+			want: []string{"test-001"},
+		},
+		{
+			name: "default does not create index gaps",
+			def:  &ResourceDefServerGroup{Zones: []string{"is1a", "is1b"}},
+			setup: func(resources Resources) {
+				resources[3].(*ResourceServerGroupInstance).server.Tags = types.Tags{ScaleInProtectionTag}
+			},
+			n:    2,
+			want: nil,
+		},
+		{
+			name: "default with a protected server in the middle",
+			def:  &ResourceDefServerGroup{Zones: []string{"is1a", "is1b"}},
+			setup: func(resources Resources) {
+				resources[1].(*ResourceServerGroupInstance).server.Tags = types.Tags{ScaleInProtectionTag}
+			},
+			n:    3,
+			want: []string{"test-003", "test-004"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resources := testZoneBalanceResources("is1a", "is1a", "is1a", "is1b")
+			for _, r := range resources {
+				r.(*ResourceServerGroupInstance).server.InstanceStatus = types.ServerInstanceStatuses.Up
+			}
+			if tt.setup != nil {
+				tt.setup(resources)
+			}
+			ctx := NewRequestContext(context.Background(), &requestInfo{}, test.Logger)
+
+			err := tt.def.markForScaleIn(ctx, resources, tt.n)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, testDeletedServerNames(resources))
+		})
+	}
+}
+
+func testScaleInCreatedAt(resources Resources, base time.Time, hours ...int) {
+	for i, h := range hours {
+		resources[i].(*ResourceServerGroupInstance).server.CreatedAt = base.Add(time.Duration(h) * time.Hour)
+	}
+}
+
+func TestResourceDefServerGroup_healingSize(t *testing.T) {
+	servers := []*iaas.Server{
+		{Name: "test-002"},
+		{Name: "test-004"},
+	}
+	tests := []struct {
+		name string
+		def  *ResourceDefServerGroup
+		want int
+	}{
+		{
+			name: "default fills gaps",
+			def:  &ResourceDefServerGroup{ServerNamePrefix: "test", MaxSize: 5},
+			want: 4,
+		},
+		{
+			name: "gaps allowed",
+			def:  &ResourceDefServerGroup{ServerNamePrefix: "test", MaxSize: 5, ScaleInPolicy: ScaleInPolicyOldest},
+			want: 2,
+		},
+		{
+			name: "gaps allowed with min_size",
+			def:  &ResourceDefServerGroup{ServerNamePrefix: "test", MinSize: 3, MaxSize: 5, ScaleInPolicy: ScaleInPolicyOldest},
+			want: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.def.healingSize(servers))
+		})
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"slices"
 	"strings"
 
 	"github.com/c-robinson/iplib"
@@ -29,7 +30,9 @@ import (
 	"github.com/sacloud/iaas-api-go"
 	"github.com/sacloud/iaas-api-go/helper/query"
 	"github.com/sacloud/iaas-api-go/ostype"
+	"github.com/sacloud/iaas-api-go/search"
 	"github.com/sacloud/iaas-api-go/types"
+	"github.com/sacloud/packages-go/size"
 )
 
 type ServerGroupInstanceTemplate struct {
@@ -49,11 +52,39 @@ type ServerGroupInstanceTemplate struct {
 
 	InterfaceDriver types.EInterfaceDriver `yaml:"interface_driver" validate:"omitempty,oneof=virtio e1000"`
 
-	Plan              *ServerGroupInstancePlan     `yaml:"plan" validate:"required"`
-	Disks             []*ServerGroupDiskTemplate   `yaml:"disks" validate:"max=4"`
-	EditParameter     *ServerGroupDiskEditTemplate `yaml:"edit_parameter"`
-	CloudConfig       ServerGroupCloudConfig       `yaml:",inline"`
-	NetworkInterfaces []*ServerGroupNICTemplate    `yaml:"network_interfaces" validate:"max=10"`
+	Plan *ServerGroupInstancePlan `yaml:"plan" validate:"required"`
+	// FallbackPlans Planが売り切れなどで利用できない場合に優先順に試行するプラン
+	FallbackPlans []*ServerGroupInstancePlan `yaml:"fallback_plans" validate:"omitempty,dive,required"`
+	// ZonePlans ゾーンごとのプランの上書き、指定したゾーンではPlan/FallbackPlansの代わりにリストの先頭から優先順に試行する
+	ZonePlans         map[string][]*ServerGroupInstancePlan `yaml:"zone_plans" validate:"omitempty,dive,keys,required,endkeys,min=1,dive,required"`
+	Disks             []*ServerGroupDiskTemplate            `yaml:"disks" validate:"max=4"`
+	EditParameter     *ServerGroupDiskEditTemplate          `yaml:"edit_parameter"`
+	CloudConfig       ServerGroupCloudConfig                `yaml:",inline"`
+	NetworkInterfaces []*ServerGroupNICTemplate             `yaml:"network_interfaces" validate:"max=10"`
+
+	// raw コンフィギュレーションに記載されたテンプレートをキー順に正規化したもの、インスタンスリフレッシュのフィンガープリントの算出に利用する
+	raw []byte
+}
+
+func (s *ServerGroupInstanceTemplate) UnmarshalYAML(ctx context.Context, data []byte) error {
+	type alias ServerGroupInstanceTemplate
+	var v alias
+	if err := yaml.UnmarshalContext(ctx, data, &v, yaml.Strict()); err != nil {
+		return err
+	}
+
+	var raw interface{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	normalized, err := yaml.Marshal(raw)
+	if err != nil {
+		return err
+	}
+
+	*s = ServerGroupInstanceTemplate(v)
+	s.raw = normalized
+	return nil
 }
 
 // Validate .
@@ -93,9 +124,23 @@ func (s *ServerGroupInstanceTemplate) Validate(ctx context.Context, apiClient ia
 		}
 	}
 
+	for zone := range s.ZonePlans {
+		if !slices.Contains(def.Zones, zone) {
+			errors = multierror.Append(errors, validate.Errorf("zone_plans: zone %q is not included in zones", zone))
+		}
+	}
+
 	for _, zone := range def.Zones {
-		if err := s.Plan.Validate(ctx, apiClient, zone); err != nil {
-			errors = multierror.Append(errors, err)
+		// 候補のプランのいずれかが存在すれば良い
+		var planErrors []error
+		plans := s.plansForZone(zone)
+		for _, plan := range plans {
+			if err := plan.Validate(ctx, apiClient, zone); err != nil {
+				planErrors = append(planErrors, err)
+			}
+		}
+		if len(planErrors) == len(plans) {
+			errors = multierror.Append(errors, planErrors...)
 		}
 		for _, disk := range s.Disks {
 			errors = multierror.Append(errors, disk.Validate(ctx, apiClient, zone)...)
@@ -105,13 +150,15 @@ func (s *ServerGroupInstanceTemplate) Validate(ctx context.Context, apiClient ia
 	// TODO EditParameter/CloudConfigそれぞれにおいて、Disks[0]が存在&対応していることを検証
 	//  https://github.com/sacloud/autoscaler/issues/255 の対応時に合わせて対応する。
 
+	// テンプレートはサンプル値で評価して検証する
+	sample := sampleServerGroupTemplateData(def, s)
 	switch {
 	case s.EditParameter != nil && !s.CloudConfig.Empty():
 		errors = multierror.Append(errors, fmt.Errorf("only one of edit_parameter and cloud_config can be specified"))
 	case s.EditParameter != nil:
-		errors = multierror.Append(errors, s.EditParameter.Validate()...)
+		errors = multierror.Append(errors, s.EditParameter.Validate(sample)...)
 	case !s.CloudConfig.Empty():
-		errors = multierror.Append(errors, s.CloudConfig.Validate()...)
+		errors = multierror.Append(errors, s.CloudConfig.Validate(sample)...)
 	}
 
 	for i, nic := range s.NetworkInterfaces {
@@ -198,6 +245,40 @@ type ServerGroupInstancePlan struct {
 	DedicatedCPU bool   `yaml:"dedicated_cpu"`
 }
 
+// hasFallbackPlans 代替のプランが指定されている場合true
+func (s *ServerGroupInstanceTemplate) hasFallbackPlans() bool {
+	return len(s.FallbackPlans) > 0 || len(s.ZonePlans) > 0
+}
+
+// plansForZone 指定ゾーンで試行するプランを優先順に返す
+func (s *ServerGroupInstanceTemplate) plansForZone(zone string) []*ServerGroupInstancePlan {
+	if plans, ok := s.ZonePlans[zone]; ok && len(plans) > 0 {
+		return plans
+	}
+	return append([]*ServerGroupInstancePlan{s.Plan}, s.FallbackPlans...)
+}
+
+// SelectPlan 指定ゾーンで利用可能な最初のプランと、それ以降の代替プランを返す
+//
+// 代替のプランが指定されていない場合はAPIでの確認を行わずPlanを返す
+func (s *ServerGroupInstanceTemplate) SelectPlan(ctx context.Context, apiClient iaas.APICaller, zone string) (*ServerGroupInstancePlan, []*ServerGroupInstancePlan, error) {
+	if !s.hasFallbackPlans() {
+		return s.Plan, nil, nil
+	}
+
+	plans := s.plansForZone(zone)
+	for i, plan := range plans {
+		available, err := plan.available(ctx, apiClient, zone)
+		if err != nil {
+			return nil, nil, err
+		}
+		if available {
+			return plan, plans[i+1:], nil
+		}
+	}
+	return nil, nil, fmt.Errorf("no available plan found in zone %s", zone)
+}
+
 func (p *ServerGroupInstancePlan) Validate(ctx context.Context, apiClient iaas.APICaller, zone string) error {
 	_, err := query.FindServerPlan(ctx, iaas.NewServerPlanOp(apiClient), zone, &query.FindServerPlanRequest{
 		CPU:        p.Core,
@@ -215,6 +296,57 @@ func (p *ServerGroupInstancePlan) Validate(ctx context.Context, apiClient iaas.A
 	return nil
 }
 
+// available 指定ゾーンでプランが利用可能な場合true
+func (p *ServerGroupInstancePlan) available(ctx context.Context, apiClient iaas.APICaller, zone string) (bool, error) {
+	found, err := iaas.NewServerPlanOp(apiClient).Find(ctx, zone, p.findCondition())
+	if err != nil {
+		return false, err
+	}
+	for _, plan := range found.ServerPlans {
+		// 検索条件に加えてスペックが一致するかも確認する
+		matched := plan.CPU == p.Core &&
+			plan.GetMemoryGB() == p.Memory &&
+			plan.GPU == p.GPU &&
+			(p.CPUModel == "" || plan.CPUModel == p.CPUModel) &&
+			plan.Commitment == boolToCommitment(p.DedicatedCPU)
+		if matched && plan.Availability.IsAvailable() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (p *ServerGroupInstancePlan) findCondition() *iaas.FindCondition {
+	cond := &iaas.FindCondition{
+		Filter: search.Filter{
+			search.Key("CPU"):        p.Core,
+			search.Key("MemoryMB"):   size.GiBToMiB(p.Memory),
+			search.Key("Commitment"): boolToCommitment(p.DedicatedCPU),
+		},
+	}
+	if p.GPU > 0 {
+		cond.Filter[search.Key("GPU")] = p.GPU
+	}
+	if p.CPUModel != "" {
+		cond.Filter[search.Key("CPUModel")] = p.CPUModel
+	}
+	return cond
+}
+
+// tag 作成時に選択されたプランを記録するためのタグ
+func (p *ServerGroupInstancePlan) tag() string {
+	return handler.ServerPlanTag(uint32(p.Core), uint32(p.Memory), uint32(p.GPU), p.DedicatedCPU)
+}
+
+// equals サーバのプランがこのプランと一致する場合true
+func (p *ServerGroupInstancePlan) equals(server *iaas.Server) bool {
+	return server.CPU == p.Core &&
+		server.GetMemoryGB() == p.Memory &&
+		server.GPU == p.GPU &&
+		(p.CPUModel == "" || server.CPUModel == p.CPUModel) &&
+		server.Commitment.IsDedicatedCPU() == p.DedicatedCPU
+}
+
 func (p *ServerGroupInstancePlan) String() string {
 	return fmt.Sprintf("Core:%d, Memory:%d, DedicatedCPU:%t, GPU:%d, CPUModel:%s",
 		p.Core, p.Memory, p.DedicatedCPU, p.GPU, p.CPUModel)
@@ -389,7 +521,7 @@ type ServerGroupDiskEditTemplate struct {
 	SSHKeys []config.StringOrFilePath `yaml:"ssh_keys"`
 }
 
-func (t *ServerGroupDiskEditTemplate) Validate() []error {
+func (t *ServerGroupDiskEditTemplate) Validate(data *ServerGroupTemplateData) []error {
 	hasValue := t.HostNamePrefix != "" ||
 		t.Password != "" ||
 		len(t.StartupScripts) > 0 ||
@@ -402,11 +534,30 @@ func (t *ServerGroupDiskEditTemplate) Validate() []error {
 	if t.HostNamePrefix != "" && t.HostNameFormat != "" {
 		return []error{validate.Errorf("only one of host_name_prefix and host_name_format can be specified")}
 	}
+
+	if _, err := t.RenderStartupScripts(data); err != nil {
+		return []error{validate.Errorf("invalid startup_scripts template: %s", err)}
+	}
 	return nil
 }
 
+// RenderStartupScripts スタートアップスクリプトをGoテンプレートとして評価する
+func (t *ServerGroupDiskEditTemplate) RenderStartupScripts(data *ServerGroupTemplateData) ([]string, error) {
+	var scripts []string
+	for i, ss := range t.StartupScripts {
+		script, err := renderTemplate(fmt.Sprintf("startup_scripts[%d]", i), ss.String(), data)
+		if err != nil {
+			return nil, err
+		}
+		scripts = append(scripts, script)
+	}
+	return scripts, nil
+}
+
 type ServerGroupCloudConfig struct {
 	CloudConfig config.StringOrFilePath `yaml:"cloud_config"`
+	// CloudConfigTemplate trueの場合cloud_configをGoテンプレートとして評価する
+	CloudConfigTemplate bool `yaml:"cloud_config_template"`
 }
 
 func (c ServerGroupCloudConfig) String() string {
@@ -417,10 +568,26 @@ func (c ServerGroupCloudConfig) Empty() bool {
 	return c.CloudConfig.String() == ""
 }
 
-func (c ServerGroupCloudConfig) Validate() []error {
+// Render cloud-configをGoテンプレートとして評価する
+//
+// CloudConfigTemplateがfalseの場合やcloud-initのJinjaテンプレートの場合は評価せずそのまま返す
+func (c ServerGroupCloudConfig) Render(data *ServerGroupTemplateData) (string, error) {
+	text := c.CloudConfig.String()
+	if !c.CloudConfigTemplate || strings.HasPrefix(strings.TrimSpace(text), jinjaTemplateHeader) {
+		return text, nil
+	}
+	return renderTemplate("cloud_config", text, data)
+}
+
+func (c ServerGroupCloudConfig) Validate(data *ServerGroupTemplateData) []error {
+	rendered, err := c.Render(data)
+	if err != nil {
+		return []error{validate.Errorf("invalid cloud-config template: %s", err)}
+	}
+
 	var m map[string]interface{}
 	opts := []yaml.DecodeOption{yaml.Strict()}
-	if err := yaml.UnmarshalWithOptions([]byte(c.CloudConfig.String()), &m, opts...); err != nil {
+	if err := yaml.UnmarshalWithOptions([]byte(rendered), &m, opts...); err != nil {
 		return []error{validate.Errorf("invalid cloud-config: %s", err)}
 	}
 	return nil
@@ -59,6 +59,7 @@ plan:
 					Core:   1,
 					Memory: 1,
 				},
+				raw: []byte("plan:\n  core: 1\n  memory: 1\n"),
 			},
 		},
 		{
@@ -93,6 +94,17 @@ cloud_config: "#cloud-config"
 				CloudConfig: ServerGroupCloudConfig{
 					CloudConfig: test.StringOrFilePath(t, "#cloud-config"),
 				},
+				raw: []byte(`cloud_config: "#cloud-config"
+disks:
+- size: 20
+  source_archive:
+    names:
+    - Ubuntu
+    - cloudimg
+plan:
+  core: 1
+  memory: 1
+`),
 			},
 		},
 	}
@@ -418,6 +430,21 @@ func TestServerGroupInstanceTemplate_Validate(t *testing.T) {
 				fmt.Errorf("only one of edit_parameter and cloud_config can be specified"),
 			},
 		},
+		{
+			name: "zone_plans with unknown zone",
+			template: &ServerGroupInstanceTemplate{
+				Plan: &ServerGroupInstancePlan{
+					Core:   1,
+					Memory: 1,
+				},
+				ZonePlans: map[string][]*ServerGroupInstancePlan{
+					"is1b": {{Core: 2, Memory: 4}},
+				},
+			},
+			want: []error{
+				validate.Errorf("zone_plans: zone \"is1b\" is not included in zones"),
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -432,6 +459,77 @@ func TestServerGroupInstanceTemplate_Validate(t *testing.T) {
 	}
 }
 
+func TestServerGroupInstanceTemplate_SelectPlan(t *testing.T) {
+	// Note: fakeデータの1Core/2CoreプランはMemoryMBの値が正しくないため、GPUプランとコア専有プランを利用する
+	unavailable := &ServerGroupInstancePlan{Core: 999, Memory: 999}
+	fallback := &ServerGroupInstancePlan{Core: 4, Memory: 56, GPU: 1}
+	zonePlan := &ServerGroupInstancePlan{Core: 32, Memory: 120, DedicatedCPU: true, CPUModel: "amd_epyc_7713p"}
+
+	tests := []struct {
+		name          string
+		template      *ServerGroupInstanceTemplate
+		wantPlan      *ServerGroupInstancePlan
+		wantFallbacks []*ServerGroupInstancePlan
+		wantErr       bool
+	}{
+		{
+			name:     "without fallback plans",
+			template: &ServerGroupInstanceTemplate{Plan: unavailable},
+			wantPlan: unavailable, // 代替プランがない場合は確認しない
+		},
+		{
+			name: "primary plan is available",
+			template: &ServerGroupInstanceTemplate{
+				Plan:          fallback,
+				FallbackPlans: []*ServerGroupInstancePlan{zonePlan},
+			},
+			wantPlan:      fallback,
+			wantFallbacks: []*ServerGroupInstancePlan{zonePlan},
+		},
+		{
+			name: "falls back to next plan",
+			template: &ServerGroupInstanceTemplate{
+				Plan:          unavailable,
+				FallbackPlans: []*ServerGroupInstancePlan{fallback, zonePlan},
+			},
+			wantPlan:      fallback,
+			wantFallbacks: []*ServerGroupInstancePlan{zonePlan},
+		},
+		{
+			name: "zone plans override",
+			template: &ServerGroupInstanceTemplate{
+				Plan:          fallback,
+				FallbackPlans: []*ServerGroupInstancePlan{fallback},
+				ZonePlans: map[string][]*ServerGroupInstancePlan{
+					test.Zone: {unavailable, zonePlan},
+				},
+			},
+			wantPlan:      zonePlan,
+			wantFallbacks: []*ServerGroupInstancePlan{},
+		},
+		{
+			name: "no available plan",
+			template: &ServerGroupInstanceTemplate{
+				Plan:          unavailable,
+				FallbackPlans: []*ServerGroupInstancePlan{unavailable},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, fallbacks, err := tt.template.SelectPlan(context.Background(), test.APIClient, test.Zone)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantPlan, plan)
+			require.Equal(t, tt.wantFallbacks, fallbacks)
+		})
+	}
+}
+
 func TestServerGroupNICTemplate_Validate(t *testing.T) {
 	type args struct {
 		maxServerNum int
@@ -0,0 +1,135 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+
+	"github.com/sacloud/autoscaler/handler"
+)
+
+// jinjaTemplateHeader cloud-initのJinjaテンプレートであることを示すヘッダ
+//
+// このヘッダを持つcloud-configはcloud-init側で評価されるため、cloud_config_templateがtrueでもGoテンプレートとしては評価しない
+const jinjaTemplateHeader = "## template: jinja"
+
+// ServerGroupTemplateData cloud_config(cloud_config_templateがtrueの場合)とstartup_scriptsをGoテンプレートとして評価する際に参照できる値
+type ServerGroupTemplateData struct {
+	Index       int                        // グループ内でのインデックス(0始まり)
+	Number      int                        // グループ内での連番(1始まり)
+	Zone        string                     // サーバを配置するゾーン
+	ServerName  string                     // サーバ名
+	IPAddress   string                     // 1番目のNICに割り当てられたIPアドレス、共有セグメントなど作成前に決まらない場合は空
+	IPAddresses []string                   // 各NICに割り当てられたIPアドレス、NICの順序に対応する
+	GroupName   string                     // ServerGroupの名前
+	Parent      *ServerGroupTemplateParent // 親リソース、親がない場合はnil
+	Source      string                     // リクエスト元(Inputsから渡されたsource)
+
+	// ServerGroupInstance 後方互換のためのフィールド
+	//
+	// 以前のバージョンではスタートアップスクリプトはハンドラー上で*handler.ServerGroupInstanceを元に評価されていたため、
+	// .Nameや.NetworkInterfacesなどハンドラーへ渡す値も引き続き参照できるようにしておく。
+	// ZoneとParentは上記のフィールドが優先される
+	*handler.ServerGroupInstance
+}
+
+// ServerGroupTemplateParent テンプレートから参照できる親リソースの情報
+type ServerGroupTemplateParent struct {
+	Type string
+	ID   string
+	Name string
+	Zone string
+}
+
+// ServerGroupNameTemplateData server_name_formatをGoテンプレートとして評価する際に参照できる値
+//
+// サーバ名はグループ内のサーバの識別に用いるため、インデックスから一意に決まる値のみ参照できる
+type ServerGroupNameTemplateData struct {
+	Prefix    string // server_name_prefix(省略時はname)
+	GroupName string // ServerGroupの名前
+	Index     int    // グループ内でのインデックス(0始まり)
+	Number    int    // グループ内での連番(1始まり)
+}
+
+// sampleServerGroupTemplateData 設定ファイルの検証時にテンプレートを評価するためのサンプル値を返す
+func sampleServerGroupTemplateData(def *ResourceDefServerGroup, tmpl *ServerGroupInstanceTemplate) *ServerGroupTemplateData {
+	zone := ""
+	if len(def.Zones) > 0 {
+		zone = def.Zones[0]
+	}
+	serverName := def.serverNameByIndex(0)
+
+	data := &ServerGroupTemplateData{
+		Index:      0,
+		Number:     1,
+		Zone:       zone,
+		ServerName: serverName,
+		GroupName:  def.Name(),
+		Source:     "default",
+		ServerGroupInstance: &handler.ServerGroupInstance{
+			Zone: zone,
+			Name: serverName,
+		},
+	}
+	for range tmpl.NetworkInterfaces {
+		data.IPAddresses = append(data.IPAddresses, "192.0.2.11")
+		data.NetworkInterfaces = append(data.NetworkInterfaces, &handler.ServerGroupInstance_NIC{
+			Upstream:      "shared",
+			UserIpAddress: "192.0.2.11",
+			AssignedNetwork: &handler.NetworkInfo{
+				IpAddress: "192.0.2.11",
+				Netmask:   24,
+				Gateway:   "192.0.2.1",
+			},
+		})
+	}
+	if len(data.IPAddresses) > 0 {
+		data.IPAddress = data.IPAddresses[0]
+	}
+	if def.ParentDef != nil {
+		data.Parent = &ServerGroupTemplateParent{
+			Type: def.ParentDef.Type().String(),
+			ID:   "123456789012",
+			Name: "example",
+			Zone: zone,
+		}
+	}
+	return data
+}
+
+// isGoTemplate 文字列がGoテンプレートのアクションを含むか
+func isGoTemplate(text string) bool {
+	return strings.Contains(text, "{{")
+}
+
+// renderTemplate textをGoテンプレートとして評価する
+//
+// アクションを含まない場合は評価せずそのまま返す
+func renderTemplate(name, text string, data interface{}) (string, error) {
+	if !isGoTemplate(text) {
+		return text, nil
+	}
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	buf := bytes.NewBufferString("")
+	if err := tmpl.Execute(buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
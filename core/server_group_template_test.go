@@ -0,0 +1,249 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sacloud/autoscaler/config"
+	"github.com/sacloud/autoscaler/handler"
+	"github.com/sacloud/autoscaler/test"
+	"github.com/sacloud/iaas-api-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderTemplate(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		data    *ServerGroupTemplateData
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "without actions",
+			text: "#cloud-config",
+			data: &ServerGroupTemplateData{},
+			want: "#cloud-config",
+		},
+		{
+			name: "minimum",
+			text: "{{.Name}}",
+			data: &ServerGroupTemplateData{ServerGroupInstance: &handler.ServerGroupInstance{Name: "test"}},
+			want: "test",
+		},
+		{
+			name: "network interfaces",
+			text: "{{ range .NetworkInterfaces }}{{.Upstream}}{{ if .AssignedNetwork }}:{{ .AssignedNetwork.IpAddress }}{{ end }},{{ end }}",
+			data: &ServerGroupTemplateData{ServerGroupInstance: &handler.ServerGroupInstance{
+				Name: "test",
+				NetworkInterfaces: []*handler.ServerGroupInstance_NIC{
+					{
+						Upstream: "shared",
+					},
+					{
+						Upstream:      "123456789012",
+						UserIpAddress: "192.168.11.101",
+						AssignedNetwork: &handler.NetworkInfo{
+							IpAddress: "192.168.11.101",
+							Netmask:   24,
+							Gateway:   "192.169.11.1",
+							Index:     1,
+						},
+					},
+				},
+			}},
+			want: "shared,123456789012:192.168.11.101,",
+		},
+		{
+			name: "per-instance values",
+			text: "{{ .GroupName }}/{{ .ServerName }}/{{ .Number }}/{{ .Zone }}/{{ .IPAddress }}/{{ .Parent.Type }}:{{ .Parent.Name }}/{{ .Source }}",
+			data: &ServerGroupTemplateData{
+				Index:       1,
+				Number:      2,
+				Zone:        "is1a",
+				ServerName:  "test-002",
+				IPAddress:   "192.168.0.12",
+				IPAddresses: []string{"192.168.0.12"},
+				GroupName:   "test",
+				Parent:      &ServerGroupTemplateParent{Type: "ELB", ID: "123456789012", Name: "elb"},
+				Source:      "default",
+			},
+			want: "test/test-002/2/is1a/192.168.0.12/ELB:elb/default",
+		},
+		{
+			name:    "unknown field",
+			text:    "{{ .Unknown }}",
+			data:    &ServerGroupTemplateData{},
+			wantErr: true,
+		},
+		{
+			name:    "parse error",
+			text:    "{{ .Name ",
+			data:    &ServerGroupTemplateData{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := renderTemplate(tt.name, tt.text, tt.data)
+			require.Equal(t, tt.wantErr, err != nil, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestServerGroupCloudConfig_Render(t *testing.T) {
+	data := &ServerGroupTemplateData{ServerName: "test-001"}
+
+	t.Run("go template", func(t *testing.T) {
+		c := ServerGroupCloudConfig{
+			CloudConfig:         test.StringOrFilePath(t, "#cloud-config\nhostname: {{ .ServerName }}"),
+			CloudConfigTemplate: true,
+		}
+		got, err := c.Render(data)
+		require.NoError(t, err)
+		require.Equal(t, "#cloud-config\nhostname: test-001", got)
+	})
+
+	t.Run("not opted in", func(t *testing.T) {
+		text := "#cloud-config\nwrite_files:\n  - content: '{{ not a go template }}'\n    path: /etc/example"
+		c := ServerGroupCloudConfig{CloudConfig: test.StringOrFilePath(t, text)}
+		got, err := c.Render(data)
+		require.NoError(t, err)
+		require.Equal(t, text, got)
+		require.Empty(t, c.Validate(data))
+	})
+
+	t.Run("jinja template", func(t *testing.T) {
+		text := "## template: jinja\n#cloud-config\nhostname: {{ v1.local_hostname }}"
+		c := ServerGroupCloudConfig{CloudConfig: test.StringOrFilePath(t, text), CloudConfigTemplate: true}
+		got, err := c.Render(data)
+		require.NoError(t, err)
+		require.Equal(t, text, got)
+	})
+
+	t.Run("invalid template", func(t *testing.T) {
+		c := ServerGroupCloudConfig{
+			CloudConfig:         test.StringOrFilePath(t, "#cloud-config\nhostname: {{ .Unknown }}"),
+			CloudConfigTemplate: true,
+		}
+		require.Len(t, c.Validate(data), 1)
+	})
+}
+
+func TestResourceDefServerGroup_serverNameFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:   "default",
+			format: "",
+			want:   []string{"test-001", "test-002"},
+		},
+		{
+			name:   "printf",
+			format: "%s-%02d",
+			want:   []string{"test-01", "test-02"},
+		},
+		{
+			name:   "go template",
+			format: `{{ .Prefix }}-node{{ printf "%02d" .Number }}`,
+			want:   []string{"test-node01", "test-node02"},
+		},
+		{
+			name:    "without prefix",
+			format:  "node{{ .Number }}",
+			wantErr: true,
+		},
+		{
+			name:    "without index",
+			format:  "{{ .Prefix }}-{{ .GroupName }}",
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			format:  "{{ .Prefix }}-{{ .Zone }}",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &ResourceDefServerGroup{
+				ResourceDefBase: &ResourceDefBase{
+					TypeName: ResourceTypeServerGroup.String(),
+					DefName:  "test",
+				},
+				ServerNameFormat: tt.format,
+			}
+			err := d.validateServerNameFormat()
+			require.Equal(t, tt.wantErr, err != nil, err)
+			if tt.wantErr {
+				return
+			}
+			require.Equal(t, tt.want, []string{d.serverNameByIndex(0), d.serverNameByIndex(1)})
+		})
+	}
+}
+
+func TestResourceServerGroupInstance_Compute_template(t *testing.T) {
+	def := &ResourceDefServerGroup{
+		ResourceDefBase: &ResourceDefBase{
+			TypeName: ResourceTypeServerGroup.String(),
+			DefName:  "test",
+		},
+		Zone:    test.Zone,
+		MinSize: 0,
+		MaxSize: 2,
+		Template: &ServerGroupInstanceTemplate{
+			Plan: &ServerGroupInstancePlan{Core: 1, Memory: 1},
+			CloudConfig: ServerGroupCloudConfig{
+				CloudConfig:         test.StringOrFilePath(t, "#cloud-config\nhostname: {{ .ServerName }}-{{ .Zone }}-{{ .Source }}"),
+				CloudConfigTemplate: true,
+			},
+			EditParameter: &ServerGroupDiskEditTemplate{
+				StartupScripts: []config.StringOrFilePath{
+					// 以前のバージョンと同じくハンドラーへ渡す値も参照できる
+					test.StringOrFilePath(t, "{{ .Number }}:{{ .Name }}:{{ .Core }}:{{ .Description }}"),
+				},
+			},
+		},
+	}
+	r := &ResourceServerGroupInstance{
+		ResourceBase: &ResourceBase{resourceType: ResourceTypeServerGroupInstance},
+		apiClient:    test.APIClient,
+		server:       &iaas.Server{Name: "test-002", CPU: 2, Description: "desc"},
+		zone:         test.Zone,
+		def:          def,
+		instruction:  handler.ResourceInstructions_CREATE,
+		indexInGroup: 1,
+	}
+
+	ctx := NewRequestContext(context.Background(), &requestInfo{source: "grafana"}, test.Logger)
+	computed, err := r.Compute(ctx, false)
+	require.NoError(t, err)
+	require.Equal(t,
+		"#cloud-config\nhostname: test-002-"+test.Zone+"-grafana",
+		computed.Desired().GetServerGroupInstance().CloudConfig,
+	)
+	require.Equal(t,
+		[]string{"2:test-002:2:desc"},
+		computed.Desired().GetServerGroupInstance().EditParameter.StartupScripts,
+	)
+}
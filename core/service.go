@@ -16,8 +16,10 @@ package core
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 
+	"github.com/sacloud/autoscaler/defaults"
 	sacloudotel "github.com/sacloud/autoscaler/otel"
 	"github.com/sacloud/autoscaler/request"
 	"github.com/sacloud/go-otelsetup"
@@ -49,9 +51,21 @@ func (s *ScalingService) Up(ctx context.Context, req *request.ScalingRequest) (*
 	if req.DesiredStateName != "" {
 		logger = logger.With("desired", req.DesiredStateName)
 	}
+	if req.DesiredSpec != nil {
+		logger = logger.With("desired-spec", req.DesiredSpec.String())
+	}
+	if len(req.Labels) > 0 {
+		logger = logger.With("labels", req.Labels)
+	}
+	if req.PayloadRef != "" {
+		logger = logger.With("payload-ref", req.PayloadRef)
+	}
 	logger.Info("request received")
 	logger.Debug("", slog.Any("request", req))
 
+	if err := validateDesiredSpec(req); err != nil {
+		return nil, err
+	}
 	resourceName, err := s.instance.ResourceName(req.ResourceName)
 	if err != nil {
 		return nil, err
@@ -65,6 +79,8 @@ func (s *ScalingService) Up(ctx context.Context, req *request.ScalingRequest) (*
 			attribute.String("sacloud.autoscaler.request.resource_name", req.ResourceName),
 			attribute.String("sacloud.autoscaler.request.desired_state_name", req.DesiredStateName),
 			attribute.Bool("sacloud.autoscaler.request.sync", req.Sync),
+			attribute.Int("sacloud.autoscaler.request.step", int(req.Step)),
+			attribute.String("sacloud.autoscaler.request.payload_ref", req.PayloadRef),
 		),
 	)
 	defer span.End()
@@ -76,6 +92,10 @@ func (s *ScalingService) Up(ctx context.Context, req *request.ScalingRequest) (*
 		resourceName:     resourceName,
 		desiredStateName: req.DesiredStateName,
 		sync:             req.Sync,
+		step:             req.Step,
+		labels:           req.Labels,
+		payloadRef:       req.PayloadRef,
+		desiredSpec:      req.DesiredSpec,
 	}, s.instance.logger)
 	job, message, err := s.instance.Up(serviceCtx)
 	if err != nil {
@@ -96,9 +116,21 @@ func (s *ScalingService) Down(ctx context.Context, req *request.ScalingRequest)
 	if req.DesiredStateName != "" {
 		logger = logger.With("desired", req.DesiredStateName)
 	}
+	if req.DesiredSpec != nil {
+		logger = logger.With("desired-spec", req.DesiredSpec.String())
+	}
+	if len(req.Labels) > 0 {
+		logger = logger.With("labels", req.Labels)
+	}
+	if req.PayloadRef != "" {
+		logger = logger.With("payload-ref", req.PayloadRef)
+	}
 	logger.Info("request received")
 	logger.Debug("", slog.Any("request", req))
 
+	if err := validateDesiredSpec(req); err != nil {
+		return nil, err
+	}
 	resourceName, err := s.instance.ResourceName(req.ResourceName)
 	if err != nil {
 		return nil, err
@@ -112,6 +144,8 @@ func (s *ScalingService) Down(ctx context.Context, req *request.ScalingRequest)
 			attribute.String("sacloud.autoscaler.request.resource_name", req.ResourceName),
 			attribute.String("sacloud.autoscaler.request.desired_state_name", req.DesiredStateName),
 			attribute.Bool("sacloud.autoscaler.request.sync", req.Sync),
+			attribute.Int("sacloud.autoscaler.request.step", int(req.Step)),
+			attribute.String("sacloud.autoscaler.request.payload_ref", req.PayloadRef),
 		),
 	)
 	defer span.End()
@@ -123,6 +157,10 @@ func (s *ScalingService) Down(ctx context.Context, req *request.ScalingRequest)
 		resourceName:     resourceName,
 		desiredStateName: req.DesiredStateName,
 		sync:             req.Sync,
+		step:             req.Step,
+		labels:           req.Labels,
+		payloadRef:       req.PayloadRef,
+		desiredSpec:      req.DesiredSpec,
 	}, s.instance.logger)
 	job, message, err := s.instance.Down(serviceCtx)
 	if err != nil {
@@ -143,9 +181,21 @@ func (s *ScalingService) Keep(ctx context.Context, req *request.ScalingRequest)
 	if req.DesiredStateName != "" {
 		logger = logger.With("desired", req.DesiredStateName)
 	}
+	if req.DesiredSpec != nil {
+		logger = logger.With("desired-spec", req.DesiredSpec.String())
+	}
+	if len(req.Labels) > 0 {
+		logger = logger.With("labels", req.Labels)
+	}
+	if req.PayloadRef != "" {
+		logger = logger.With("payload-ref", req.PayloadRef)
+	}
 	logger.Info("request received")
 	logger.Debug("", slog.Any("request", req))
 
+	if err := validateDesiredSpec(req); err != nil {
+		return nil, err
+	}
 	resourceName, err := s.instance.ResourceName(req.ResourceName)
 	if err != nil {
 		return nil, err
@@ -159,6 +209,8 @@ func (s *ScalingService) Keep(ctx context.Context, req *request.ScalingRequest)
 			attribute.String("sacloud.autoscaler.request.resource_name", req.ResourceName),
 			attribute.String("sacloud.autoscaler.request.desired_state_name", req.DesiredStateName),
 			attribute.Bool("sacloud.autoscaler.request.sync", req.Sync),
+			attribute.Int("sacloud.autoscaler.request.step", int(req.Step)),
+			attribute.String("sacloud.autoscaler.request.payload_ref", req.PayloadRef),
 		),
 	)
 	defer span.End()
@@ -170,6 +222,10 @@ func (s *ScalingService) Keep(ctx context.Context, req *request.ScalingRequest)
 		resourceName:     resourceName,
 		desiredStateName: req.DesiredStateName,
 		sync:             req.Sync,
+		step:             req.Step,
+		labels:           req.Labels,
+		payloadRef:       req.PayloadRef,
+		desiredSpec:      req.DesiredSpec,
 	}, s.instance.logger)
 	job, message, err := s.instance.Keep(serviceCtx)
 	if err != nil {
@@ -182,6 +238,187 @@ func (s *ScalingService) Keep(ctx context.Context, req *request.ScalingRequest)
 	}, nil
 }
 
+func (s *ScalingService) Refresh(ctx context.Context, req *request.ScalingRequest) (*request.ScalingResponse, error) {
+	logger := s.instance.logger.With(
+		"request", requestTypeRefresh.String(),
+		"resource", req.ResourceName,
+	)
+	if len(req.Labels) > 0 {
+		logger = logger.With("labels", req.Labels)
+	}
+	if req.PayloadRef != "" {
+		logger = logger.With("payload-ref", req.PayloadRef)
+	}
+	logger.Info("request received")
+	logger.Debug("", slog.Any("request", req))
+
+	resourceName, err := s.instance.ResourceName(req.ResourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	traceCtx, span := sacloudotel.Tracer().Start(otelsetup.ContextForTrace(context.Background()), "ScalingService#Refresh",
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("sacloud.autoscaler.request.type", requestTypeRefresh.String()),
+			attribute.String("sacloud.autoscaler.request.source", req.Source),
+			attribute.String("sacloud.autoscaler.request.resource_name", req.ResourceName),
+			attribute.Bool("sacloud.autoscaler.request.sync", req.Sync),
+			attribute.String("sacloud.autoscaler.request.payload_ref", req.PayloadRef),
+		),
+	)
+	defer span.End()
+
+	// リクエストには即時応答を返しつつバックグラウンドでジョブを実行するために引数のctxは引き継がない
+	serviceCtx := NewRequestContext(traceCtx, &requestInfo{
+		requestType:  requestTypeRefresh,
+		source:       req.Source,
+		resourceName: resourceName,
+		sync:         req.Sync,
+		labels:       req.Labels,
+		payloadRef:   req.PayloadRef,
+	}, s.instance.logger)
+	job, message, err := s.instance.Refresh(serviceCtx)
+	if err != nil {
+		return nil, err
+	}
+	return &request.ScalingResponse{
+		ScalingJobId: job.ID(),
+		Status:       job.Status(),
+		Message:      message,
+	}, nil
+}
+
+func (s *ScalingService) Adopt(ctx context.Context, req *request.AdoptRequest) (*request.AdoptResponse, error) {
+	logger := s.instance.logger.With(
+		"request", requestTypeAdopt.String(),
+		"resource", req.ResourceName,
+		"dry-run", req.DryRun,
+	)
+	logger.Info("request received")
+	logger.Debug("", slog.Any("request", req))
+
+	resourceName, err := s.instance.ResourceName(req.ResourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	traceCtx, span := sacloudotel.Tracer().Start(otelsetup.ContextForTrace(context.Background()), "ScalingService#Adopt",
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("sacloud.autoscaler.request.type", requestTypeAdopt.String()),
+			attribute.String("sacloud.autoscaler.request.source", req.Source),
+			attribute.String("sacloud.autoscaler.request.resource_name", req.ResourceName),
+			attribute.Bool("sacloud.autoscaler.request.sync", req.Sync),
+			attribute.Bool("sacloud.autoscaler.request.dry_run", req.DryRun),
+		),
+	)
+	defer span.End()
+
+	// リクエストには即時応答を返しつつバックグラウンドでジョブを実行するために引数のctxは引き継がない
+	serviceCtx := NewRequestContext(traceCtx, &requestInfo{
+		requestType:  requestTypeAdopt,
+		source:       req.Source,
+		resourceName: resourceName,
+		sync:         req.Sync,
+	}, s.instance.logger)
+	job, candidates, message, err := s.instance.Adopt(serviceCtx, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &request.AdoptResponse{Message: message}
+	if job != nil {
+		res.ScalingJobId = job.ID()
+		res.Status = job.Status()
+	}
+	for _, c := range candidates {
+		res.Candidates = append(res.Candidates, &request.AdoptionCandidate{
+			ResourceName: c.ResourceName,
+			ServerId:     c.ServerID,
+			Zone:         c.Zone,
+			CurrentName:  c.CurrentName,
+			NewName:      c.NewName,
+			Reason:       c.Reason,
+		})
+	}
+	return res, nil
+}
+
+func (s *ScalingService) Drift(ctx context.Context, req *request.DriftRequest) (*request.DriftResponse, error) {
+	logger := s.instance.logger.With("request", "Drift", "resource", req.ResourceName)
+	logger.Info("request received")
+
+	traceCtx, span := sacloudotel.Tracer().Start(otelsetup.ContextForTrace(ctx), "ScalingService#Drift",
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("sacloud.autoscaler.request.resource_name", req.ResourceName),
+		),
+	)
+	defer span.End()
+
+	// 参照のみのため同期的に処理し、呼び出し元のctxを引き継ぐ
+	serviceCtx := NewRequestContext(traceCtx, &requestInfo{
+		requestType:  requestTypeUnknown,
+		source:       driftDetectionSourceName,
+		resourceName: req.ResourceName,
+	}, s.instance.logger)
+	drifts, err := s.instance.DetectDrift(serviceCtx, req.ResourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &request.DriftResponse{}
+	for _, drift := range drifts {
+		instance := &request.InstanceDrift{
+			ResourceName: drift.ResourceName,
+			ServerId:     drift.ServerID,
+			ServerName:   drift.ServerName,
+			Zone:         drift.Zone,
+		}
+		for _, f := range drift.Fields {
+			instance.Fields = append(instance.Fields, &request.FieldDrift{
+				Field:    f.Field,
+				Expected: f.Expected,
+				Actual:   f.Actual,
+			})
+		}
+		res.Instances = append(res.Instances, instance)
+	}
+	return res, nil
+}
+
+func (s *ScalingService) CompleteLifecycleAction(ctx context.Context, req *request.CompleteLifecycleActionRequest) (*request.CompleteLifecycleActionResponse, error) {
+	logger := s.instance.logger.With(
+		"request", "CompleteLifecycleAction",
+		"resource", req.ResourceName,
+		"hook", req.Hook,
+		"action", req.Action,
+	)
+	if req.ServerId != "" {
+		logger = logger.With("server-id", req.ServerId)
+	}
+	if req.ServerName != "" {
+		logger = logger.With("server-name", req.ServerName)
+	}
+	logger.Info("request received")
+
+	_, span := sacloudotel.Tracer().Start(otelsetup.ContextForTrace(ctx), "ScalingService#CompleteLifecycleAction",
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("sacloud.autoscaler.request.resource_name", req.ResourceName),
+			attribute.String("sacloud.autoscaler.request.hook", req.Hook),
+			attribute.String("sacloud.autoscaler.request.action", req.Action),
+		),
+	)
+	defer span.End()
+
+	if err := s.instance.CompleteLifecycleAction(req.ResourceName, req.Hook, req.ServerId, req.ServerName, req.Action); err != nil {
+		return nil, err
+	}
+	return &request.CompleteLifecycleActionResponse{}, nil
+}
+
 // Check gRPCヘルスチェックの実装
 func (s *ScalingService) Check(context.Context, *health.HealthCheckRequest) (*health.HealthCheckResponse, error) {
 	return &health.HealthCheckResponse{
@@ -193,3 +430,11 @@ func (s *ScalingService) Check(context.Context, *health.HealthCheckRequest) (*he
 func (s *ScalingService) Watch(*health.HealthCheckRequest, health.Health_WatchServer) error {
 	return status.Error(codes.Unimplemented, "unimplemented")
 }
+
+// validateDesiredSpec DesiredStateNameとDesiredSpecが同時に指定されていないか検証する
+func validateDesiredSpec(req *request.ScalingRequest) error {
+	if req.DesiredSpec != nil && req.DesiredStateName != "" && req.DesiredStateName != defaults.DesiredStateName {
+		return fmt.Errorf("request parameter 'DesiredStateName' and 'DesiredSpec' cannot be specified at the same time")
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"slices"
+
+	"github.com/sacloud/autoscaler/handler"
+	"github.com/sacloud/iaas-api-go"
+)
+
+// WarmPool ServerGroupのウォームプール(スケールアウトに備えて作成済み・停止状態で待機させておくサーバ)の設定
+//
+// Upリクエスト時は新規作成の代わりにプールのサーバを起動して親リソースにアタッチする。
+// プールのサーバは名前とタグを変更した上で起動されるが、ディスクの修正(ホスト名など)は作成時のプール用の名前で行われる点に注意
+type WarmPool struct {
+	// Size プールに待機させておくサーバ台数
+	Size int `yaml:"size" validate:"min=0"`
+}
+
+func (p *WarmPool) enabled() bool {
+	return p != nil && p.Size > 0
+}
+
+// WarmPoolTag ウォームプールのサーバに付与されるタグ
+const WarmPoolTag = "@autoscaler-warm-pool"
+
+// warmPoolNameSuffix ウォームプールのサーバ名でnamePrefixの後ろに付与する文字列
+const warmPoolNameSuffix = "-pool"
+
+func isPooledServer(server *iaas.Server) bool {
+	return slices.Contains(server.Tags, WarmPoolTag)
+}
+
+// splitPooledServers serversをウォームプール以外のサーバとウォームプールのサーバに分ける
+func splitPooledServers(servers []*iaas.Server) (active []*iaas.Server, pooled []*iaas.Server) {
+	for _, server := range servers {
+		if isPooledServer(server) {
+			pooled = append(pooled, server)
+		} else {
+			active = append(active, server)
+		}
+	}
+	return active, pooled
+}
+
+func (d *ResourceDefServerGroup) poolServerNameByIndex(index int) string {
+	return d.serverNameWithPrefix(d.namePrefix()+warmPoolNameSuffix, index)
+}
+
+// determinePoolServerName プールに追加するサーバの名前を決定する、途中抜けがあった場合は抜けている番号から割り当てる
+func (d *ResourceDefServerGroup) determinePoolServerName(names []string) (string, int) {
+	for i := 0; ; i++ {
+		name := d.poolServerNameByIndex(i)
+		if !slices.Contains(names, name) {
+			return name, i
+		}
+	}
+}
+
+// takePooledServer pooledからzoneのサーバを優先して1台取り出す、プールが空の場合はnilを返す
+func takePooledServer(pooled *[]*iaas.Server, zone string) *iaas.Server {
+	if len(*pooled) == 0 {
+		return nil
+	}
+	i := slices.IndexFunc(*pooled, func(s *iaas.Server) bool { return s.Zone != nil && s.Zone.Name == zone })
+	if i < 0 {
+		i = 0
+	}
+	server := (*pooled)[i]
+	*pooled = slices.Delete(*pooled, i, i+1)
+	return server
+}
+
+// createResourceFromPool プールのサーバをname/indexのサーバとして起動するためのリソースを返す
+//
+// 作成指示(CREATE)としつつ既存サーバのIDを持たせることで、ハンドラーに新規作成ではなくプールからの起動であることを示す
+func (d *ResourceDefServerGroup) createResourceFromPool(ctx *RequestContext, apiClient iaas.APICaller, parent Resource, iconId string, pooled *iaas.Server, name string, index int) (*ResourceServerGroupInstance, error) {
+	zone := pooled.Zone.Name
+	resource, err := d.createResourceWithCreateInstruction(ctx.WithZone(zone), apiClient, parent, iconId, zone, name, index)
+	if err != nil {
+		return nil, err
+	}
+	resource.server.ID = pooled.ID
+	return resource, nil
+}
+
+// buildInstancesForWarmPool プールのサーバ台数をWarmPool.Sizeに合わせるためのリソースを返す
+//
+// 不足分はプール用の名前で作成し(起動はしない)、余剰分は削除する
+func (d *ResourceDefServerGroup) buildInstancesForWarmPool(ctx *RequestContext, apiClient iaas.APICaller, pooled []*iaas.Server) (Resources, error) {
+	size := 0
+	if d.WarmPool.enabled() {
+		size = d.WarmPool.Size
+	}
+
+	var resources Resources
+	var names []string
+	for i, server := range pooled {
+		if i < size {
+			names = append(names, server.Name)
+			continue
+		}
+		resource := d.createResourceFromServer(apiClient, nil, server)
+		resource.instruction = handler.ResourceInstructions_DELETE
+		resource.pooled = true
+		resources = append(resources, resource)
+	}
+	if len(pooled) >= size {
+		return resources, nil
+	}
+
+	iconId, err := d.Template.FindIconId(ctx, apiClient)
+	if err != nil {
+		return nil, err
+	}
+	for count := len(pooled); count < size; count++ {
+		name, index := d.determinePoolServerName(names)
+		zone := d.determineZone(index)
+
+		// プールのサーバは親リソースにアタッチしないためparentはnilとする
+		resource, err := d.createResourceWithCreateInstruction(ctx.WithZone(zone), apiClient, nil, iconId, zone, name, index)
+		if err != nil {
+			return nil, err
+		}
+		resource.server.Tags = append(slices.Clone(resource.server.Tags), WarmPoolTag)
+		resource.pooled = true
+
+		resources = append(resources, resource)
+		names = append(names, name)
+	}
+	return resources, nil
+}
@@ -0,0 +1,128 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sacloud/autoscaler/handler"
+	"github.com/sacloud/autoscaler/test"
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/iaas-api-go/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testPooledServer(name, zone string) *iaas.Server {
+	return &iaas.Server{
+		ID:   types.StringID("1"),
+		Name: name,
+		Tags: types.Tags{WarmPoolTag},
+		Zone: &iaas.ZoneInfo{Name: zone},
+	}
+}
+
+func TestSplitPooledServers(t *testing.T) {
+	servers := []*iaas.Server{
+		{Name: "test-001"},
+		testPooledServer("test-pool-001", "is1a"),
+		{Name: "test-002"},
+	}
+	active, pooled := splitPooledServers(servers)
+	require.Equal(t, []*iaas.Server{servers[0], servers[2]}, active)
+	require.Equal(t, []*iaas.Server{servers[1]}, pooled)
+}
+
+func TestTakePooledServer(t *testing.T) {
+	pooled := []*iaas.Server{
+		testPooledServer("test-pool-001", "is1a"),
+		testPooledServer("test-pool-002", "is1b"),
+	}
+
+	require.Equal(t, "test-pool-002", takePooledServer(&pooled, "is1b").Name, "same zone")
+	require.Equal(t, "test-pool-001", takePooledServer(&pooled, "is1b").Name, "other zone")
+	require.Nil(t, takePooledServer(&pooled, "is1b"), "empty")
+}
+
+func TestResourceDefServerGroup_buildInstancesForWarmPool(t *testing.T) {
+	tests := []struct {
+		name     string
+		warmPool *WarmPool
+		pooled   []*iaas.Server
+		want     []string
+	}{
+		{
+			name:     "disabled",
+			warmPool: nil,
+			want:     nil,
+		},
+		{
+			name:     "fill",
+			warmPool: &WarmPool{Size: 3},
+			pooled:   []*iaas.Server{testPooledServer("test-pool-002", "is1b")},
+			want:     []string{"CREATE:test-pool-001", "CREATE:test-pool-003"},
+		},
+		{
+			name:     "satisfied",
+			warmPool: &WarmPool{Size: 1},
+			pooled:   []*iaas.Server{testPooledServer("test-pool-001", "is1a")},
+			want:     nil,
+		},
+		{
+			name:     "shrink",
+			warmPool: &WarmPool{Size: 1},
+			pooled: []*iaas.Server{
+				testPooledServer("test-pool-001", "is1a"),
+				testPooledServer("test-pool-002", "is1b"),
+			},
+			want: []string{"DELETE:test-pool-002"},
+		},
+		{
+			name:     "disabled with remaining servers",
+			warmPool: &WarmPool{Size: 0},
+			pooled:   []*iaas.Server{testPooledServer("test-pool-001", "is1a")},
+			want:     []string{"DELETE:test-pool-001"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &ResourceDefServerGroup{
+				ResourceDefBase:  &ResourceDefBase{TypeName: "ServerGroup", DefName: "test"},
+				ServerNamePrefix: "test",
+				Zones:            []string{"is1a", "is1b"},
+				MaxSize:          5,
+				Template: &ServerGroupInstanceTemplate{
+					Tags: []string{"tag1"},
+					Plan: &ServerGroupInstancePlan{Core: 1, Memory: 1},
+				},
+				WarmPool: tt.warmPool,
+			}
+			ctx := NewRequestContext(context.Background(), &requestInfo{requestType: requestTypeKeep}, test.Logger)
+
+			got, err := d.buildInstancesForWarmPool(ctx, nil, tt.pooled)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, testRefreshInstructions(got))
+
+			for _, r := range got {
+				instance := r.(*ResourceServerGroupInstance)
+				require.True(t, instance.pooled)
+				require.Nil(t, instance.parent)
+				if instance.instruction == handler.ResourceInstructions_CREATE {
+					require.Equal(t, []string{"tag1", WarmPoolTag}, []string(instance.server.Tags))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"slices"
+
+	"github.com/sacloud/autoscaler/handler"
+)
+
+// ZoneBalance 複数ゾーンにまたがるServerGroupでゾーン間の台数の偏りを抑えるための設定
+type ZoneBalance struct {
+	// Enabled trueの場合、新規サーバは最も台数の少ないゾーンに配置し、スケールイン時は最も台数の多いゾーンから削除する
+	Enabled bool `yaml:"enabled"`
+	// Rebalance trueの場合、Keepリクエスト時にゾーン間で2台以上の偏りがあれば台数を減らさずにサーバを1台ずつ移動する
+	Rebalance bool `yaml:"rebalance"`
+}
+
+func (z *ZoneBalance) enabled() bool {
+	return z != nil && z.Enabled
+}
+
+func (z *ZoneBalance) rebalance() bool {
+	return z.enabled() && z.Rebalance
+}
+
+// zoneCounts ゾーンごとのサーバ台数
+type zoneCounts map[string]int
+
+// zoneCountsOf resourcesのうち削除予定でないサーバのゾーンごとの台数を返す
+func zoneCountsOf(resources Resources) zoneCounts {
+	counts := make(zoneCounts)
+	for _, r := range resources {
+		instance := r.(*ResourceServerGroupInstance)
+		if instance.instruction == handler.ResourceInstructions_DELETE {
+			continue
+		}
+		counts[instance.zone]++
+	}
+	return counts
+}
+
+// least zonesのうち台数が最も少ないゾーンを返す、同数の場合はzonesの中で先に現れるものを返す
+func (c zoneCounts) least(zones []string) string {
+	var found string
+	for _, zone := range zones {
+		if found == "" || c[zone] < c[found] {
+			found = zone
+		}
+	}
+	return found
+}
+
+// most zonesのうち台数が最も多いゾーンを返す、同数の場合はzonesの中で後に現れるものを返す
+func (c zoneCounts) most(zones []string) string {
+	var found string
+	for _, zone := range zones {
+		if found == "" || c[zone] >= c[found] {
+			found = zone
+		}
+	}
+	return found
+}
+
+// zoneForNewServer 新規サーバを配置すべきゾーンを決定する
+//
+// ZoneBalanceが有効な場合は台数が最も少ないゾーン、そうでない場合はインデックスから決定する
+func (d *ResourceDefServerGroup) zoneForNewServer(counts zoneCounts, index int) string {
+	if d.ZoneBalance.enabled() && len(d.Zones) > 1 {
+		return counts.least(d.Zones)
+	}
+	return d.determineZone(index)
+}
+
+// zoneBalancedVictim candidatesのうち、台数が最も多いゾーンの中でインデックスが最も大きいサーバのインデックスを返す
+//
+// 台数は削除可能なサーバが存在するゾーンの中で比較する。ゾーンが1つしかない場合は-1を返す
+func (d *ResourceDefServerGroup) zoneBalancedVictim(resources Resources, candidates []*ResourceServerGroupInstance) int {
+	if len(d.Zones) < 2 {
+		return -1
+	}
+	var zones []string
+	for _, zone := range d.Zones {
+		if slices.ContainsFunc(candidates, func(c *ResourceServerGroupInstance) bool { return c.zone == zone }) {
+			zones = append(zones, zone)
+		}
+	}
+	zone := zoneCountsOf(resources).most(zones)
+	for i := len(candidates) - 1; i >= 0; i-- {
+		if candidates[i].zone == zone {
+			return i
+		}
+	}
+	return -1
+}
+
+// rebalanceTarget ゾーン間の台数の偏りを解消するために移動すべきサーバと移動先ゾーンを返す
+//
+// 台数の差が1台以下の場合や移動可能なサーバが無い場合はnilを返す。スケールイン保護されたサーバは移動対象としない
+func (d *ResourceDefServerGroup) rebalanceTarget(resources Resources) (*ResourceServerGroupInstance, string) {
+	if !d.ZoneBalance.rebalance() || len(d.Zones) < 2 {
+		return nil, ""
+	}
+	counts := zoneCountsOf(resources)
+	from := counts.most(d.Zones)
+	to := counts.least(d.Zones)
+	if counts[from]-counts[to] <= 1 {
+		return nil, ""
+	}
+	for i := len(resources) - 1; i >= 0; i-- {
+		instance := resources[i].(*ResourceServerGroupInstance)
+		if instance.zone == from && !instance.isProtected() {
+			return instance, to
+		}
+	}
+	return nil, ""
+}
@@ -0,0 +1,140 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/sacloud/autoscaler/handler"
+	"github.com/sacloud/autoscaler/test"
+	"github.com/sacloud/iaas-api-go"
+	"github.com/stretchr/testify/require"
+)
+
+func testZoneBalanceResources(zones ...string) Resources {
+	var resources Resources
+	for i, zone := range zones {
+		resources = append(resources, &ResourceServerGroupInstance{
+			ResourceBase: &ResourceBase{resourceType: ResourceTypeServerGroupInstance},
+			server:       &iaas.Server{Name: fmt.Sprintf("test-%03d", i+1)},
+			zone:         zone,
+			instruction:  handler.ResourceInstructions_NOOP,
+			indexInGroup: i,
+		})
+	}
+	return resources
+}
+
+func testDeletedServerNames(resources Resources) []string {
+	var names []string
+	for _, r := range resources {
+		instance := r.(*ResourceServerGroupInstance)
+		if instance.instruction == handler.ResourceInstructions_DELETE {
+			names = append(names, instance.server.Name)
+		}
+	}
+	return names
+}
+
+func TestResourceDefServerGroup_zoneForNewServer(t *testing.T) {
+	counts := zoneCounts{"is1a": 3, "is1b": 1, "tk1a": 1}
+
+	d := &ResourceDefServerGroup{Zones: []string{"is1a", "is1b", "tk1a"}}
+	require.Equal(t, "is1a", d.zoneForNewServer(counts, 3), "index-based placement")
+
+	d.ZoneBalance = &ZoneBalance{Enabled: true}
+	require.Equal(t, "is1b", d.zoneForNewServer(counts, 3), "least-populated zone")
+}
+
+func TestResourceDefServerGroup_markForScaleIn(t *testing.T) {
+	ctx := NewRequestContext(context.Background(), &requestInfo{}, test.Logger)
+
+	t.Run("index-based", func(t *testing.T) {
+		d := &ResourceDefServerGroup{Zones: []string{"is1a", "is1b"}}
+		resources := testZoneBalanceResources("is1a", "is1a", "is1a", "is1b")
+		require.NoError(t, d.markForScaleIn(ctx, resources, 2))
+		require.Equal(t, []string{"test-003", "test-004"}, testDeletedServerNames(resources))
+	})
+
+	t.Run("zone-balanced", func(t *testing.T) {
+		d := &ResourceDefServerGroup{Zones: []string{"is1a", "is1b"}, ZoneBalance: &ZoneBalance{Enabled: true}}
+		resources := testZoneBalanceResources("is1a", "is1a", "is1a", "is1b")
+		require.NoError(t, d.markForScaleIn(ctx, resources, 2))
+		require.Equal(t, []string{"test-002", "test-003"}, testDeletedServerNames(resources))
+	})
+}
+
+func TestResourceDefServerGroup_rebalanceTarget(t *testing.T) {
+	tests := []struct {
+		name       string
+		balance    *ZoneBalance
+		zones      []string
+		protected  []int
+		wantServer string
+		wantZone   string
+	}{
+		{
+			name:    "rebalance disabled",
+			balance: &ZoneBalance{Enabled: true},
+			zones:   []string{"is1a", "is1a", "is1a", "is1b"},
+		},
+		{
+			name:    "balanced",
+			balance: &ZoneBalance{Enabled: true, Rebalance: true},
+			zones:   []string{"is1a", "is1b", "is1a"},
+		},
+		{
+			name:       "skewed",
+			balance:    &ZoneBalance{Enabled: true, Rebalance: true},
+			zones:      []string{"is1a", "is1a", "is1a", "is1b"},
+			wantServer: "test-003",
+			wantZone:   "is1b",
+		},
+		{
+			name:       "skip protected",
+			balance:    &ZoneBalance{Enabled: true, Rebalance: true},
+			zones:      []string{"is1a", "is1a", "is1a", "is1b"},
+			protected:  []int{2},
+			wantServer: "test-002",
+			wantZone:   "is1b",
+		},
+		{
+			name:      "all protected",
+			balance:   &ZoneBalance{Enabled: true, Rebalance: true},
+			zones:     []string{"is1a", "is1a", "is1a", "is1b"},
+			protected: []int{0, 1, 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resources := testZoneBalanceResources(tt.zones...)
+			for _, i := range tt.protected {
+				instance := resources[i].(*ResourceServerGroupInstance)
+				instance.server.Tags = append(instance.server.Tags, ScaleInProtectionTag)
+			}
+
+			d := &ResourceDefServerGroup{Zones: []string{"is1a", "is1b"}, ZoneBalance: tt.balance}
+			target, zone := d.rebalanceTarget(resources)
+			if tt.wantServer == "" {
+				require.Nil(t, target)
+				return
+			}
+			require.Equal(t, tt.wantServer, target.server.Name)
+			require.Equal(t, tt.wantZone, zone)
+		})
+	}
+}
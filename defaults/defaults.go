@@ -28,6 +28,10 @@ const (
 
 	CoolDownTime        = 10 * time.Minute // 同一ジョブの実行制御のための冷却期間
 	ShutdownGracePeriod = 10 * time.Minute
+	IdleTimeout         = 30 * time.Minute // ServerGroupのアイドルタイムアウト(台数を0にするまでの期間)
+
+	DriftDetectionInterval = 10 * time.Minute // ServerGroupのドリフトの定期検出の間隔
+	LifecycleHookTimeout   = 5 * time.Minute  // ServerGroupのライフサイクルフックの完了待ちのタイムアウト
 )
 
 var (
@@ -14,7 +14,27 @@
 
 package handler
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ServerPlanTagPrefix ServerGroupのサーバに、作成時に選択されたプランを記録するタグのプレフィックス
+const ServerPlanTagPrefix = "@plan="
+
+// ServerPlanTag 作成時に選択されたプランを表すタグを返す
+//
+// タグの長さ制限(32文字)のためCPUモデルは含めない
+func ServerPlanTag(core, memory, gpu uint32, dedicatedCPU bool) string {
+	tag := fmt.Sprintf("%s%dcore-%dgb", ServerPlanTagPrefix, core, memory)
+	if gpu > 0 {
+		tag += fmt.Sprintf("-%dgpu", gpu)
+	}
+	if dedicatedCPU {
+		tag += "-dedicated"
+	}
+	return tag
+}
 
 func (x *ServerGroupInstance_NIC) EachIPAndExposedPort(fn func(ip string, port int) error) error {
 	if x == nil || x.ExposeInfo == nil || x.AssignedNetwork == nil {
@@ -0,0 +1,74 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlers
+
+import (
+	"time"
+
+	"github.com/sacloud/autoscaler/handler"
+)
+
+// DrainPollingInterval ドレイン中にアクティブな接続数を確認する間隔
+var DrainPollingInterval = 10 * time.Second
+
+// ActiveConnectionsFunc 切り離し対象のサーバのアクティブな接続数を返す
+type ActiveConnectionsFunc func() (int, error)
+
+// Drain 無効化したサーバへの接続がなくなるのを待つ
+//
+// activeConnectionsがnilの場合はtimeoutまで待機する。
+// nil以外の場合はDrainPollingIntervalごとに接続数を確認し、0になった時点かtimeoutで待機を終了する。
+// 待機の状況はRUNNINGステータスのメッセージとして報告する
+func Drain(ctx *HandlerContext, timeout time.Duration, activeConnections ActiveConnectionsFunc) error {
+	if timeout <= 0 {
+		return nil
+	}
+	if err := ctx.Report(handler.HandleResponse_RUNNING, "draining...: {Timeout:%s}", timeout); err != nil {
+		return err
+	}
+
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	var tick <-chan time.Time
+	if activeConnections != nil {
+		ticker := time.NewTicker(DrainPollingInterval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		if activeConnections != nil {
+			count, err := activeConnections()
+			if err != nil {
+				return err
+			}
+			if count == 0 {
+				return ctx.Report(handler.HandleResponse_RUNNING, "drained: no active connections")
+			}
+			if err := ctx.Report(handler.HandleResponse_RUNNING, "draining...: {ActiveConnections:%d}", count); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-deadline.C:
+			return ctx.Report(handler.HandleResponse_RUNNING, "drain timeout exceeded")
+		case <-tick:
+		}
+	}
+}
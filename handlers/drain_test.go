@@ -0,0 +1,94 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sacloud/autoscaler/handler"
+	"github.com/stretchr/testify/require"
+)
+
+type drainTestSender struct {
+	logs []string
+}
+
+func (s *drainTestSender) Send(res *handler.HandleResponse) error {
+	s.logs = append(s.logs, res.Log)
+	return nil
+}
+
+func TestDrain(t *testing.T) {
+	DrainPollingInterval = 10 * time.Millisecond
+
+	tests := []struct {
+		name              string
+		timeout           time.Duration
+		activeConnections []int
+		wantLast          string
+	}{
+		{
+			name:     "without timeout",
+			timeout:  0,
+			wantLast: "",
+		},
+		{
+			name:     "waits until timeout without polling",
+			timeout:  50 * time.Millisecond,
+			wantLast: "drain timeout exceeded",
+		},
+		{
+			name:              "finishes when active connections reach zero",
+			timeout:           time.Minute,
+			activeConnections: []int{3, 1, 0},
+			wantLast:          "drained: no active connections",
+		},
+		{
+			name:              "timeout with remaining connections",
+			timeout:           50 * time.Millisecond,
+			activeConnections: []int{1},
+			wantLast:          "drain timeout exceeded",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sender := &drainTestSender{}
+			ctx := NewHandlerContext(context.Background(), "test", sender)
+
+			var fn ActiveConnectionsFunc
+			if tt.activeConnections != nil {
+				i := 0
+				fn = func() (int, error) {
+					// 最後の値を返し続ける
+					count := tt.activeConnections[i]
+					if i < len(tt.activeConnections)-1 {
+						i++
+					}
+					return count, nil
+				}
+			}
+
+			require.NoError(t, Drain(ctx, tt.timeout, fn))
+			last := ""
+			if len(sender.logs) > 0 {
+				last = sender.logs[len(sender.logs)-1]
+			}
+			require.Equal(t, tt.wantLast, last)
+		})
+	}
+}
@@ -16,6 +16,7 @@ package elb
 
 import (
 	"context"
+	"time"
 
 	"github.com/sacloud/autoscaler/handler"
 	"github.com/sacloud/autoscaler/handlers"
@@ -33,6 +34,7 @@ import (
 //   - PostHandle: ELBへのアタッチ
 //
 // アタッチ/デタッチは各サーバのEnabledを制御することで行う
+// 親リソースにdrain_timeoutが指定されている場合、デタッチ時はサーバを無効化してから接続のドレインを待つ
 // もしELBにサーバが1台しか登録されていない場合はサービス停止が発生するため注意が必要
 type ServersHandler struct {
 	handlers.HandlerLogger
@@ -178,24 +180,18 @@ func (h *ServersHandler) attachOrDetach(ctx *handlers.HandlerContext, server *ha
 		return err
 	}
 
-	if _, err := elbOp.UpdateSettings(ctx, types.StringID(elb.Id), &iaas.ProxyLBUpdateSettingsRequest{
-		HealthCheck:          current.HealthCheck,
-		SorryServer:          current.SorryServer,
-		BindPorts:            current.BindPorts,
-		Servers:              current.Servers,
-		Rules:                current.Rules,
-		LetsEncrypt:          current.LetsEncrypt,
-		StickySession:        current.StickySession,
-		Timeout:              current.Timeout,
-		Gzip:                 current.Gzip,
-		BackendHttpKeepAlive: current.BackendHttpKeepAlive,
-		ProxyProtocol:        current.ProxyProtocol,
-		Syslog:               current.Syslog,
-		SettingsHash:         current.SettingsHash,
-	}); err != nil {
+	if _, err := elbOp.UpdateSettings(ctx, types.StringID(elb.Id), updateSettingsRequest(current)); err != nil {
 		return err
 	}
 
+	// デタッチ後、シャットダウンの前に接続のドレインを待つ
+	if !attach {
+		if err := handlers.Drain(ctx, time.Duration(elb.DrainTimeout)*time.Second,
+			h.activeConnections(ctx, elbOp, elb, []*iaas.ProxyLBServer{{IPAddress: targetIPAddress}})); err != nil {
+			return err
+		}
+	}
+
 	return ctx.Report(handler.HandleResponse_DONE,
 		"updated: {Enabled:%t, IPAddress:%s}", attach, targetIPAddress)
 }
@@ -256,21 +252,7 @@ func (h *ServersHandler) addServer(ctx *handlers.HandlerContext, instance *handl
 		if err := ctx.Report(handler.HandleResponse_RUNNING, "updating..."); err != nil {
 			return err
 		}
-		_, err := elbOp.UpdateSettings(ctx, current.ID, &iaas.ProxyLBUpdateSettingsRequest{
-			HealthCheck:          current.HealthCheck,
-			SorryServer:          current.SorryServer,
-			BindPorts:            current.BindPorts,
-			Servers:              current.Servers,
-			Rules:                current.Rules,
-			LetsEncrypt:          current.LetsEncrypt,
-			StickySession:        current.StickySession,
-			Timeout:              current.Timeout,
-			Gzip:                 current.Gzip,
-			BackendHttpKeepAlive: current.BackendHttpKeepAlive,
-			ProxyProtocol:        current.ProxyProtocol,
-			Syslog:               current.Syslog,
-			SettingsHash:         current.SettingsHash,
-		})
+		_, err := elbOp.UpdateSettings(ctx, current.ID, updateSettingsRequest(current))
 		if err != nil {
 			return err
 		}
@@ -301,6 +283,18 @@ func (h *ServersHandler) deleteServer(ctx *handlers.HandlerContext, instance *ha
 		return ctx.Report(handler.HandleResponse_IGNORED, "instance.network_interface[0] has no expose info")
 	}
 
+	// 削除の前に無効化して接続のドレインを待つ
+	if elb.DrainTimeout > 0 {
+		if err := h.disableAndDrain(ctx, elbOp, current, nic, elb); err != nil {
+			return err
+		}
+		// 無効化によりSettingsHashが変わっているため再取得
+		current, err = elbOp.Read(ctx, types.StringID(elb.Id))
+		if err != nil {
+			return err
+		}
+	}
+
 	shouldUpdate := false
 	fn := func(ip string, port int) error {
 		var servers []*iaas.ProxyLBServer
@@ -326,21 +320,7 @@ func (h *ServersHandler) deleteServer(ctx *handlers.HandlerContext, instance *ha
 		if err := ctx.Report(handler.HandleResponse_RUNNING, "updating..."); err != nil {
 			return err
 		}
-		_, err := elbOp.UpdateSettings(ctx, current.ID, &iaas.ProxyLBUpdateSettingsRequest{
-			HealthCheck:          current.HealthCheck,
-			SorryServer:          current.SorryServer,
-			BindPorts:            current.BindPorts,
-			Servers:              current.Servers,
-			Rules:                current.Rules,
-			LetsEncrypt:          current.LetsEncrypt,
-			StickySession:        current.StickySession,
-			Timeout:              current.Timeout,
-			Gzip:                 current.Gzip,
-			BackendHttpKeepAlive: current.BackendHttpKeepAlive,
-			ProxyProtocol:        current.ProxyProtocol,
-			Syslog:               current.Syslog,
-			SettingsHash:         current.SettingsHash,
-		})
+		_, err := elbOp.UpdateSettings(ctx, current.ID, updateSettingsRequest(current))
 		if err != nil {
 			return err
 		}
@@ -351,3 +331,74 @@ func (h *ServersHandler) deleteServer(ctx *handlers.HandlerContext, instance *ha
 
 	return ctx.Report(handler.HandleResponse_DONE)
 }
+
+// disableAndDrain 対象サーバを無効化し、接続のドレインを待つ
+func (h *ServersHandler) disableAndDrain(ctx *handlers.HandlerContext, elbOp iaas.ProxyLBAPI, current *iaas.ProxyLB, nic *handler.ServerGroupInstance_NIC, elb *handler.ELB) error {
+	var targets []*iaas.ProxyLBServer
+	fn := func(ip string, port int) error {
+		for _, s := range current.Servers {
+			if s.IPAddress == ip && s.Port == port && s.Enabled {
+				s.Enabled = false
+				targets = append(targets, s)
+				if err := ctx.Report(handler.HandleResponse_RUNNING,
+					"disabled: Server{IP: %s, Port:%d}", ip, port); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	if err := nic.EachIPAndExposedPort(fn); err != nil {
+		return err
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+
+	if err := ctx.Report(handler.HandleResponse_RUNNING, "updating..."); err != nil {
+		return err
+	}
+	if _, err := elbOp.UpdateSettings(ctx, current.ID, updateSettingsRequest(current)); err != nil {
+		return err
+	}
+	return handlers.Drain(ctx, time.Duration(elb.DrainTimeout)*time.Second, h.activeConnections(ctx, elbOp, elb, targets))
+}
+
+// activeConnections 対象サーバのアクティブな接続数の合計を返すfuncを返す
+//
+// targetsのPortが0の場合はIPアドレスのみで判定する
+func (h *ServersHandler) activeConnections(ctx *handlers.HandlerContext, elbOp iaas.ProxyLBAPI, elb *handler.ELB, targets []*iaas.ProxyLBServer) handlers.ActiveConnectionsFunc {
+	return func() (int, error) {
+		status, err := elbOp.HealthStatus(ctx, types.StringID(elb.Id))
+		if err != nil {
+			return 0, err
+		}
+		count := 0
+		for _, s := range status.Servers {
+			for _, target := range targets {
+				if s.IPAddress == target.IPAddress && (target.Port == 0 || s.Port.Int() == target.Port) {
+					count += s.ActiveConn.Int()
+				}
+			}
+		}
+		return count, nil
+	}
+}
+
+func updateSettingsRequest(current *iaas.ProxyLB) *iaas.ProxyLBUpdateSettingsRequest {
+	return &iaas.ProxyLBUpdateSettingsRequest{
+		HealthCheck:          current.HealthCheck,
+		SorryServer:          current.SorryServer,
+		BindPorts:            current.BindPorts,
+		Servers:              current.Servers,
+		Rules:                current.Rules,
+		LetsEncrypt:          current.LetsEncrypt,
+		StickySession:        current.StickySession,
+		Timeout:              current.Timeout,
+		Gzip:                 current.Gzip,
+		BackendHttpKeepAlive: current.BackendHttpKeepAlive,
+		ProxyProtocol:        current.ProxyProtocol,
+		Syslog:               current.Syslog,
+		SettingsHash:         current.SettingsHash,
+	}
+}
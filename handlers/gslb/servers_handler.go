@@ -16,6 +16,7 @@ package gslb
 
 import (
 	"context"
+	"time"
 
 	"github.com/sacloud/autoscaler/handler"
 	"github.com/sacloud/autoscaler/handlers"
@@ -33,6 +34,7 @@ import (
 //   - PostHandle: GSLBへのアタッチ
 //
 // アタッチ/デタッチは各サーバのEnabledを制御することで行う
+// 親リソースにdrain_timeoutが指定されている場合、デタッチ時はサーバを無効化してから接続のドレインを待つ
 // もしGSLBにサーバが1台しか登録されていない場合はサービス停止が発生するため注意が必要
 type ServersHandler struct {
 	handlers.HandlerLogger
@@ -174,6 +176,13 @@ func (h *ServersHandler) attachAndDetach(ctx *handlers.HandlerContext, server *h
 		return err
 	}
 
+	// デタッチ後、シャットダウンの前に接続のドレインを待つ
+	if !attach {
+		if err := handlers.Drain(ctx, time.Duration(gslb.DrainTimeout)*time.Second, nil); err != nil {
+			return err
+		}
+	}
+
 	return ctx.Report(handler.HandleResponse_DONE,
 		"updated: {Enabled:%t, IPAddress:%s}", attach, targetIPAddress,
 	)
@@ -264,6 +273,18 @@ func (h *ServersHandler) deleteServer(ctx *handlers.HandlerContext, instance *ha
 	}
 	nic := instance.NetworkInterfaces[0]
 
+	// 削除の前に無効化して接続のドレインを待つ
+	if gslb.DrainTimeout > 0 {
+		if err := h.disableAndDrain(ctx, gslbOp, current, nic, gslb); err != nil {
+			return err
+		}
+		// 無効化によりSettingsHashが変わっているため再取得
+		current, err = gslbOp.Read(ctx, types.StringID(gslb.Id))
+		if err != nil {
+			return err
+		}
+	}
+
 	shouldUpdate := false
 	var servers []*iaas.GSLBServer
 	for _, s := range current.DestinationServers {
@@ -300,3 +321,38 @@ func (h *ServersHandler) deleteServer(ctx *handlers.HandlerContext, instance *ha
 
 	return ctx.Report(handler.HandleResponse_DONE)
 }
+
+// disableAndDrain 対象サーバを無効化し、接続のドレインを待つ
+//
+// GSLBでは接続数を取得できないため、常にdrain_timeoutまで待機する
+func (h *ServersHandler) disableAndDrain(ctx *handlers.HandlerContext, gslbOp iaas.GSLBAPI, current *iaas.GSLB, nic *handler.ServerGroupInstance_NIC, gslb *handler.GSLB) error {
+	shouldUpdate := false
+	for _, s := range current.DestinationServers {
+		if s.IPAddress == nic.AssignedNetwork.IpAddress && s.Enabled.Bool() {
+			s.Enabled = types.StringFalse
+			shouldUpdate = true
+			if err := ctx.Report(handler.HandleResponse_RUNNING,
+				"disabled: Server{IP: %s}", s.IPAddress); err != nil {
+				return err
+			}
+		}
+	}
+	if !shouldUpdate {
+		return nil
+	}
+
+	if err := ctx.Report(handler.HandleResponse_RUNNING, "updating..."); err != nil {
+		return err
+	}
+	if _, err := gslbOp.UpdateSettings(ctx, current.ID, &iaas.GSLBUpdateSettingsRequest{
+		HealthCheck:        current.HealthCheck,
+		DelayLoop:          current.DelayLoop,
+		Weighted:           current.Weighted,
+		SorryServer:        current.SorryServer,
+		DestinationServers: current.DestinationServers,
+		SettingsHash:       current.SettingsHash,
+	}); err != nil {
+		return err
+	}
+	return handlers.Drain(ctx, time.Duration(gslb.DrainTimeout)*time.Second, nil)
+}
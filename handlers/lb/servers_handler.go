@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/sacloud/autoscaler/handler"
 	"github.com/sacloud/autoscaler/handlers"
@@ -35,6 +36,7 @@ import (
 //   - PostHandle: lbへのアタッチ
 //
 // アタッチ/デタッチは各サーバのEnabledを制御することで行う
+// 親リソースにdrain_timeoutが指定されている場合、デタッチ時はサーバを無効化してから接続のドレインを待つ
 // もしLBにサーバが1台しか登録されていない場合はサービス停止が発生するため注意が必要
 type ServersHandler struct {
 	handlers.HandlerLogger
@@ -173,6 +175,14 @@ func (h *ServersHandler) attachOrDetach(ctx *handlers.HandlerContext, server *ha
 		return err
 	}
 
+	// デタッチ後、シャットダウンの前に接続のドレインを待つ
+	if !attach {
+		if err := handlers.Drain(ctx, time.Duration(lb.DrainTimeout)*time.Second,
+			h.activeConnections(ctx, lbOp, lb, []*iaas.LoadBalancerServer{{IPAddress: targetIPAddress}})); err != nil {
+			return err
+		}
+	}
+
 	return ctx.Report(handler.HandleResponse_DONE,
 		"updated: {Enabled:%t, IPAddress:%s}", attach, targetIPAddress,
 	)
@@ -336,6 +346,18 @@ func (h *ServersHandler) deleteServer(ctx *handlers.HandlerContext, instance *ha
 		return ctx.Report(handler.HandleResponse_IGNORED, "instance has no NICs")
 	}
 
+	// 削除の前に無効化して接続のドレインを待つ
+	if lb.DrainTimeout > 0 {
+		if err := h.disableAndDrain(ctx, lbOp, current, instance, lb); err != nil {
+			return err
+		}
+		// 無効化によりSettingsHashが変わっているため再取得
+		current, err = lbOp.Read(ctx, lb.Zone, types.StringID(lb.Id))
+		if err != nil {
+			return err
+		}
+	}
+
 	shouldUpdate := false
 	for _, nic := range instance.NetworkInterfaces {
 		fn := func(ip string, port int) error {
@@ -383,3 +405,68 @@ func (h *ServersHandler) deleteServer(ctx *handlers.HandlerContext, instance *ha
 
 	return ctx.Report(handler.HandleResponse_DONE)
 }
+
+// disableAndDrain 対象サーバを無効化し、接続のドレインを待つ
+func (h *ServersHandler) disableAndDrain(ctx *handlers.HandlerContext, lbOp iaas.LoadBalancerAPI, current *iaas.LoadBalancer, instance *handler.ServerGroupInstance, lb *handler.LoadBalancer) error {
+	var targets []*iaas.LoadBalancerServer
+	for _, nic := range instance.NetworkInterfaces {
+		fn := func(ip string, port int) error {
+			for _, vip := range h.filteredVIPs(current.VirtualIPAddresses, nic.ExposeInfo) {
+				for _, server := range vip.Servers {
+					if server.IPAddress == ip && server.Port.Int() == port && server.Enabled.Bool() {
+						server.Enabled = types.StringFalse
+						targets = append(targets, server)
+						if err := ctx.Report(handler.HandleResponse_RUNNING,
+							"disabled: Server{VIP:%s, IP: %s, Port:%d}", vip.VirtualIPAddress, ip, port); err != nil {
+							return err
+						}
+					}
+				}
+			}
+			return nil
+		}
+		if err := nic.EachIPAndExposedPort(fn); err != nil {
+			return err
+		}
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+
+	if err := ctx.Report(handler.HandleResponse_RUNNING, "updating..."); err != nil {
+		return err
+	}
+	if _, err := lbOp.UpdateSettings(ctx, lb.Zone, current.ID, &iaas.LoadBalancerUpdateSettingsRequest{
+		VirtualIPAddresses: current.VirtualIPAddresses,
+		SettingsHash:       current.SettingsHash,
+	}); err != nil {
+		return err
+	}
+	if err := lbOp.Config(ctx, lb.Zone, current.ID); err != nil {
+		return err
+	}
+	return handlers.Drain(ctx, time.Duration(lb.DrainTimeout)*time.Second, h.activeConnections(ctx, lbOp, lb, targets))
+}
+
+// activeConnections 対象サーバのアクティブな接続数の合計を返すfuncを返す
+//
+// targetsのPortが0の場合はIPアドレスのみで判定する
+func (h *ServersHandler) activeConnections(ctx *handlers.HandlerContext, lbOp iaas.LoadBalancerAPI, lb *handler.LoadBalancer, targets []*iaas.LoadBalancerServer) handlers.ActiveConnectionsFunc {
+	return func() (int, error) {
+		status, err := lbOp.Status(ctx, lb.Zone, types.StringID(lb.Id))
+		if err != nil {
+			return 0, err
+		}
+		count := 0
+		for _, vip := range status.Status {
+			for _, s := range vip.Servers {
+				for _, target := range targets {
+					if s.IPAddress == target.IPAddress && (target.Port == 0 || s.Port == target.Port) {
+						count += s.ActiveConn.Int()
+					}
+				}
+			}
+		}
+		return count, nil
+	}
+}
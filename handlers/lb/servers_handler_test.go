@@ -15,13 +15,29 @@
 package lb
 
 import (
+	"bytes"
+	"context"
+	"io"
 	"testing"
+	"time"
 
 	"github.com/sacloud/autoscaler/handler"
+	"github.com/sacloud/autoscaler/handlers"
+	"github.com/sacloud/autoscaler/test"
 	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/iaas-api-go/types"
 	"github.com/stretchr/testify/require"
 )
 
+type fakeSender struct {
+	buf *bytes.Buffer
+}
+
+func (s *fakeSender) Send(res *handler.HandleResponse) error {
+	_, err := io.Copy(s.buf, bytes.NewBufferString(res.Log+"\n"))
+	return err
+}
+
 func TestServersHandler_filteredVIPs(t *testing.T) {
 	type args struct {
 		vips       iaas.LoadBalancerVirtualIPAddresses
@@ -107,3 +123,68 @@ func TestServersHandler_filteredVIPs(t *testing.T) {
 		})
 	}
 }
+
+func TestServersHandler_PreHandle_drain(t *testing.T) {
+	handlers.DrainPollingInterval = 10 * time.Millisecond
+
+	lbOp := iaas.NewLoadBalancerOp(test.APIClient)
+	lb, err := lbOp.Create(context.Background(), test.Zone, &iaas.LoadBalancerCreateRequest{
+		Name:           "test-lb",
+		NetworkMaskLen: 24,
+		VirtualIPAddresses: iaas.LoadBalancerVirtualIPAddresses{
+			{
+				VirtualIPAddress: "192.168.0.11",
+				Port:             80,
+				Servers: iaas.LoadBalancerServers{
+					{IPAddress: "192.168.0.101", Port: 80, Enabled: types.StringTrue},
+					{IPAddress: "192.168.0.102", Port: 80, Enabled: types.StringTrue},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	defer lbOp.Delete(context.Background(), test.Zone, lb.ID) //nolint:errcheck
+
+	h := NewServersHandler()
+	h.SetAPICaller(test.APIClient)
+	sender := &fakeSender{buf: bytes.NewBufferString("")}
+
+	err = h.PreHandle(context.Background(), &handler.HandleRequest{
+		Source:       "default",
+		ResourceName: "default",
+		ScalingJobId: "1",
+		Instruction:  handler.ResourceInstructions_DELETE,
+		Desired: &handler.Resource{
+			Resource: &handler.Resource_ServerGroupInstance{
+				ServerGroupInstance: &handler.ServerGroupInstance{
+					Parent: &handler.Parent{
+						Resource: &handler.Parent_LoadBalancer{
+							LoadBalancer: &handler.LoadBalancer{
+								Id:           lb.ID.String(),
+								Zone:         test.Zone,
+								DrainTimeout: 1,
+							},
+						},
+					},
+					NetworkInterfaces: []*handler.ServerGroupInstance_NIC{
+						{
+							AssignedNetwork: &handler.NetworkInfo{IpAddress: "192.168.0.101"},
+							ExposeInfo:      &handler.ServerGroupInstance_ExposeInfo{Ports: []uint32{80}},
+						},
+					},
+				},
+			},
+		},
+	}, sender)
+	require.NoError(t, err)
+
+	logs := sender.buf.String()
+	require.Contains(t, logs, "disabled: Server{VIP:192.168.0.11, IP: 192.168.0.101, Port:80}")
+	require.Contains(t, logs, "draining...")
+	require.Contains(t, logs, "deleted: Server{VIP:192.168.0.11, IP: 192.168.0.101, Port:80}")
+
+	updated, err := lbOp.Read(context.Background(), test.Zone, lb.ID)
+	require.NoError(t, err)
+	require.Len(t, updated.VirtualIPAddresses[0].Servers, 1)
+	require.Equal(t, "192.168.0.102", updated.VirtualIPAddresses[0].Servers[0].IPAddress)
+}
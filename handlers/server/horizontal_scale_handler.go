@@ -15,9 +15,8 @@
 package server
 
 import (
-	"bytes"
 	"context"
-	"text/template"
+	"strings"
 	"time"
 
 	"github.com/sacloud/autoscaler/handler"
@@ -60,6 +59,10 @@ func (h *HorizontalScaleHandler) Handle(parentCtx context.Context, req *handler.
 			if err := ctx.Report(handler.HandleResponse_ACCEPTED); err != nil {
 				return err
 			}
+			// IDが指定されている場合はウォームプール(または取り込み対象)のサーバを起動する
+			if server.Id != "" {
+				return h.activatePooledServer(ctx, req, server)
+			}
 			return h.createServer(ctx, req, server)
 		case handler.ResourceInstructions_DELETE:
 			if err := ctx.Report(handler.HandleResponse_ACCEPTED); err != nil {
@@ -72,6 +75,52 @@ func (h *HorizontalScaleHandler) Handle(parentCtx context.Context, req *handler.
 	return ctx.Report(handler.HandleResponse_IGNORED)
 }
 
+// buildServerWithFallback サーバを作成する
+//
+// サーバの作成前にエラーとなった場合(プランの売り切れなど)は代替プランを優先順に試行する。
+// 代替プランで作成した場合はプランを記録するタグを置き換える
+func (h *HorizontalScaleHandler) buildServerWithFallback(ctx *handlers.HandlerContext, sb *serverBuilder.Builder, server *handler.ServerGroupInstance) (*serverBuilder.BuildResult, error) {
+	created, buildErr := sb.Build(ctx, server.Zone)
+	for _, plan := range server.FallbackPlans {
+		// サーバが作成済みの場合は重複して作成しないように代替プランは試行しない
+		if buildErr == nil || created != nil {
+			break
+		}
+		if err := ctx.Report(handler.HandleResponse_RUNNING,
+			"creating with plan {Core:%d, Memory:%d, GPU:%d} failed: %s", sb.CPU, sb.MemoryGB, sb.GPU, buildErr); err != nil {
+			return nil, err
+		}
+
+		sb.CPU = int(plan.Core)
+		sb.MemoryGB = int(plan.Memory)
+		sb.GPU = int(plan.Gpu)
+		sb.CPUModel = plan.CpuModel
+		sb.Commitment = types.Commitments.Standard
+		if plan.DedicatedCpu {
+			sb.Commitment = types.Commitments.DedicatedCPU
+		}
+		sb.Tags = replacePlanTag(sb.Tags, handler.ServerPlanTag(plan.Core, plan.Memory, plan.Gpu, plan.DedicatedCpu))
+
+		if err := ctx.Report(handler.HandleResponse_RUNNING,
+			"creating with fallback plan {Core:%d, Memory:%d, GPU:%d}...", sb.CPU, sb.MemoryGB, sb.GPU); err != nil {
+			return nil, err
+		}
+		created, buildErr = sb.Build(ctx, server.Zone)
+	}
+	return created, buildErr
+}
+
+// replacePlanTag プランを記録するタグを置き換える
+func replacePlanTag(tags types.Tags, planTag string) types.Tags {
+	var results types.Tags
+	for _, tag := range tags {
+		if !strings.HasPrefix(tag, handler.ServerPlanTagPrefix) {
+			results = append(results, tag)
+		}
+	}
+	return append(results, planTag)
+}
+
 func (h *HorizontalScaleHandler) createServer(ctx *handlers.HandlerContext, req *handler.HandleRequest, server *handler.ServerGroupInstance) error {
 	if err := ctx.Report(handler.HandleResponse_RUNNING); err != nil {
 		return err
@@ -105,7 +154,7 @@ func (h *HorizontalScaleHandler) createServer(ctx *handlers.HandlerContext, req
 		return err
 	}
 
-	created, err := sb.Build(ctx, server.Zone)
+	created, err := h.buildServerWithFallback(ctx, &sb, server)
 	if err != nil {
 		return err
 	}
@@ -117,7 +166,8 @@ func (h *HorizontalScaleHandler) createServer(ctx *handlers.HandlerContext, req
 	}
 
 	if err := ctx.Report(handler.HandleResponse_RUNNING,
-		"created: {Zone:%s, ID:%s, Name:%s}", createdServer.Zone.Name, createdServer.ID, createdServer.Name); err != nil {
+		"created: {Zone:%s, ID:%s, Name:%s, Plan:{Core:%d, Memory:%d, GPU:%d}}",
+		createdServer.Zone.Name, createdServer.ID, createdServer.Name, createdServer.CPU, createdServer.GetMemoryGB(), createdServer.GPU); err != nil {
 		return err
 	}
 
@@ -163,6 +213,11 @@ func (h *HorizontalScaleHandler) createServer(ctx *handlers.HandlerContext, req
 		}
 	}
 
+	// ウォームプールのサーバは起動せずに待機させる
+	if server.Pooled {
+		return ctx.Report(handler.HandleResponse_DONE, "created as a warm pool instance")
+	}
+
 	if err := ctx.Report(handler.HandleResponse_RUNNING, "starting..."); err != nil {
 		return err
 	}
@@ -170,6 +225,55 @@ func (h *HorizontalScaleHandler) createServer(ctx *handlers.HandlerContext, req
 		return err
 	}
 
+	return h.waitForSetup(ctx, req)
+}
+
+// activatePooledServer ウォームプールのサーバの名前やタグを更新して起動する
+//
+// 既存サーバの取り込み(adopt)の場合もこの処理で名前やタグを更新する
+func (h *HorizontalScaleHandler) activatePooledServer(ctx *handlers.HandlerContext, req *handler.HandleRequest, server *handler.ServerGroupInstance) error {
+	if err := ctx.Report(handler.HandleResponse_RUNNING); err != nil {
+		return err
+	}
+
+	serverOp := iaas.NewServerOp(h.APICaller())
+	current, err := serverOp.Read(ctx, server.Zone, types.StringID(server.Id))
+	if err != nil {
+		return err
+	}
+
+	if err := ctx.Report(handler.HandleResponse_RUNNING,
+		"activating instance: {Zone:%s, ID:%s, Name:%s}", server.Zone, current.ID, current.Name); err != nil {
+		return err
+	}
+
+	if _, err := serverOp.Update(ctx, server.Zone, current.ID, &iaas.ServerUpdateRequest{
+		Name:            server.Name,
+		Description:     server.Description,
+		Tags:            server.Tags,
+		IconID:          types.StringID(server.IconId),
+		PrivateHostID:   current.PrivateHostID,
+		InterfaceDriver: current.InterfaceDriver,
+	}); err != nil {
+		return err
+	}
+
+	// 取り込み(adopt)対象のサーバは起動済みの場合がある
+	if current.InstanceStatus.IsUp() {
+		return ctx.Report(handler.HandleResponse_DONE, "already running")
+	}
+
+	if err := ctx.Report(handler.HandleResponse_RUNNING, "starting..."); err != nil {
+		return err
+	}
+	if err := power.BootServer(ctx, serverOp, server.Zone, current.ID, server.CloudConfig); err != nil {
+		return err
+	}
+
+	return h.waitForSetup(ctx, req)
+}
+
+func (h *HorizontalScaleHandler) waitForSetup(ctx *handlers.HandlerContext, req *handler.HandleRequest) error {
 	if req.SetupGracePeriod > 0 {
 		if err := ctx.Report(handler.HandleResponse_RUNNING,
 			"waiting for setup to complete: setup_grace_period=%d", req.SetupGracePeriod); err != nil {
@@ -194,11 +298,6 @@ func (h *HorizontalScaleHandler) diskEditParameter(server *handler.ServerGroupIn
 		}
 	}
 
-	startupScripts, err := h.execStartupScriptTemplate(server, server.EditParameter.StartupScripts)
-	if err != nil {
-		return nil, err
-	}
-
 	return &diskBuilder.EditRequest{
 		HostName:            server.EditParameter.HostName,
 		Password:            server.EditParameter.Password,
@@ -212,27 +311,11 @@ func (h *HorizontalScaleHandler) diskEditParameter(server *handler.ServerGroupIn
 		SSHKeyIDs:           sshKeyIDs,
 		IsSSHKeysEphemeral:  false,
 		IsNotesEphemeral:    true,
-		NoteContents:        startupScripts,
+		NoteContents:        server.EditParameter.StartupScripts, // Goテンプレートはコアで評価済み
 		Notes:               nil,
 	}, nil
 }
 
-func (h *HorizontalScaleHandler) execStartupScriptTemplate(server *handler.ServerGroupInstance, scripts []string) ([]string, error) {
-	var startupScripts []string
-	for _, ss := range scripts {
-		tmpl, err := template.New(server.Name).Parse(ss)
-		if err != nil {
-			return nil, err
-		}
-		buf := bytes.NewBufferString("")
-		if err := tmpl.Execute(buf, server); err != nil {
-			return nil, err
-		}
-		startupScripts = append(startupScripts, buf.String())
-	}
-	return startupScripts, nil
-}
-
 func (h *HorizontalScaleHandler) networkInterface(server *handler.ServerGroupInstance) serverBuilder.NICSettingHolder {
 	if len(server.NetworkInterfaces) == 0 {
 		return nil
@@ -15,70 +15,89 @@
 package server
 
 import (
-	"reflect"
+	"bytes"
+	"context"
 	"testing"
 
 	"github.com/sacloud/autoscaler/handler"
+	"github.com/sacloud/autoscaler/test"
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/iaas-api-go/helper/power"
+	"github.com/sacloud/iaas-api-go/types"
+	"github.com/stretchr/testify/require"
 )
 
-func TestHorizontalScaleHandler_execStartupScriptTemplate(t *testing.T) {
-	type args struct {
-		server  *handler.ServerGroupInstance
-		scripts []string
-	}
-	tests := []struct {
-		name    string
-		args    args
-		want    []string
-		wantErr bool
-	}{
-		{
-			name: "minimum",
-			args: args{
-				server:  &handler.ServerGroupInstance{Name: "test"},
-				scripts: []string{"{{.Name}}"},
+func TestHorizontalScaleHandler_Handle_activatePooledServer(t *testing.T) {
+	server, cleanup := initTestServer(t)
+	defer cleanup()
+
+	h := NewHorizontalScaleHandler()
+	h.SetAPICaller(test.APIClient)
+
+	err := h.Handle(context.Background(), &handler.HandleRequest{
+		Source:       "default",
+		ResourceName: "default",
+		ScalingJobId: "1",
+		Instruction:  handler.ResourceInstructions_CREATE,
+		Desired: &handler.Resource{
+			Resource: &handler.Resource_ServerGroupInstance{
+				ServerGroupInstance: &handler.ServerGroupInstance{
+					Id:   server.ID.String(),
+					Zone: test.Zone,
+					Name: "test-server-001",
+					Tags: []string{"tag1"},
+				},
 			},
-			want:    []string{"test"},
-			wantErr: false,
 		},
-		{
-			name: "network interfaces",
-			args: args{
-				server: &handler.ServerGroupInstance{
-					Name: "test",
-					NetworkInterfaces: []*handler.ServerGroupInstance_NIC{
-						{
-							Upstream: "shared",
-						},
-						{
-							Upstream:      "123456789012",
-							UserIpAddress: "192.168.11.101",
-							AssignedNetwork: &handler.NetworkInfo{
-								IpAddress: "192.168.11.101",
-								Netmask:   24,
-								Gateway:   "192.169.11.1",
-								Index:     1,
-							},
-						},
-					},
+	}, &fakeSender{buf: bytes.NewBufferString("")})
+	require.NoError(t, err)
+
+	activated, err := iaas.NewServerOp(test.APIClient).Read(context.Background(), test.Zone, server.ID)
+	require.NoError(t, err)
+	require.Equal(t, "test-server-001", activated.Name)
+	require.Equal(t, []string{"tag1"}, []string(activated.Tags))
+	require.True(t, activated.InstanceStatus.IsUp())
+}
+
+func TestHorizontalScaleHandler_Handle_activateRunningServer(t *testing.T) {
+	server, cleanup := initTestServer(t)
+	defer cleanup()
+
+	// 取り込み(adopt)対象のサーバは起動済みの場合がある
+	serverOp := iaas.NewServerOp(test.APIClient)
+	require.NoError(t, power.BootServer(context.Background(), serverOp, test.Zone, server.ID))
+
+	h := NewHorizontalScaleHandler()
+	h.SetAPICaller(test.APIClient)
+
+	buf := bytes.NewBufferString("")
+	err := h.Handle(context.Background(), &handler.HandleRequest{
+		Source:       "default",
+		ResourceName: "default",
+		ScalingJobId: "1",
+		Instruction:  handler.ResourceInstructions_CREATE,
+		Desired: &handler.Resource{
+			Resource: &handler.Resource_ServerGroupInstance{
+				ServerGroupInstance: &handler.ServerGroupInstance{
+					Id:   server.ID.String(),
+					Zone: test.Zone,
+					Name: "test-server-002",
+					Tags: []string{"tag1"},
 				},
-				scripts: []string{"{{ range .NetworkInterfaces }}{{.Upstream}}{{ if .AssignedNetwork }}:{{ .AssignedNetwork.IpAddress }}{{ end }},{{ end }}"},
 			},
-			want:    []string{"shared,123456789012:192.168.11.101,"},
-			wantErr: false,
 		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			h := NewHorizontalScaleHandler()
-			got, err := h.execStartupScriptTemplate(tt.args.server, tt.args.scripts)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("execStartupScriptTemplate() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("execStartupScriptTemplate() got = %v, want %v", got, tt.want)
-			}
-		})
-	}
+	}, &fakeSender{buf: buf})
+	require.NoError(t, err)
+	require.Contains(t, buf.String(), "already running")
+
+	adopted, err := serverOp.Read(context.Background(), test.Zone, server.ID)
+	require.NoError(t, err)
+	require.Equal(t, "test-server-002", adopted.Name)
+	require.True(t, adopted.InstanceStatus.IsUp())
+}
+
+func TestReplacePlanTag(t *testing.T) {
+	tags := types.Tags{"tag1", handler.ServerPlanTag(2, 4, 0, false), "tag2"}
+	got := replacePlanTag(tags, handler.ServerPlanTag(4, 8, 0, true))
+	require.Equal(t, types.Tags{"tag1", "tag2", "@plan=4core-8gb-dedicated"}, got)
 }
@@ -16,14 +16,18 @@ package alertmanager
 
 import (
 	"encoding/json"
-	"io"
 	"log/slog"
 	"net/http"
+	"sort"
+	"strings"
 
+	"github.com/sacloud/autoscaler/inputs"
 	"github.com/sacloud/autoscaler/version"
 )
 
 type Input struct {
+	inputs.ScalingRequestBuilderBase
+
 	dest       string
 	addr       string
 	configPath string
@@ -63,23 +67,59 @@ func (in *Input) GetLogger() *slog.Logger {
 	return in.logger
 }
 
-func (in *Input) ShouldAccept(req *http.Request) (bool, error) {
-	if req.Method == http.MethodPost {
-		reqData, err := io.ReadAll(req.Body)
-		if err != nil {
-			return false, err
-		}
-		var received alertManagerWebhookBody
-		if err := json.Unmarshal(reqData, &received); err != nil {
-			return false, err
-		}
-		if received.Status == "firing" {
-			return true, nil
-		}
+// BuildScalingRequest inputs.ScalingRequestBuilderの実装
+//
+// アラートグループ共通のラベル/アノテーションをLabelsに、AlertmanagerのURLをPayloadRefに設定する
+func (in *Input) BuildScalingRequest(req *http.Request, base *inputs.ScalingRequest) (*inputs.ScalingRequest, error) {
+	received, err := in.parseBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if received == nil || received.Status != "firing" {
+		return nil, nil
+	}
+
+	// 同じキーの場合はラベルを優先する
+	scalingReq := base.WithLabels(received.CommonAnnotations).WithLabels(received.CommonLabels)
+	scalingReq.PayloadRef = received.ExternalURL
+	return scalingReq, nil
+}
+
+func (in *Input) parseBody(req *http.Request) (*alertManagerWebhookBody, error) {
+	var received alertManagerWebhookBody
+	if ok, err := inputs.DecodeJSONBody(req, &received, http.MethodPost); !ok || err != nil {
+		return nil, err
+	}
+	return &received, nil
+}
+
+// Fingerprint inputs.Fingerprinterの実装
+//
+// 同じアラートグループの再通知を同一とみなすため、groupKeyと各アラートのfingerprintから識別子を組み立てる
+func (in *Input) Fingerprint(body []byte) (string, error) {
+	var received alertManagerWebhookBody
+	if err := json.Unmarshal(body, &received); err != nil {
+		return "", err
+	}
+	if received.GroupKey == "" {
+		return "", nil
+	}
+
+	fingerprints := make([]string, 0, len(received.Alerts))
+	for _, alert := range received.Alerts {
+		fingerprints = append(fingerprints, alert.Fingerprint)
 	}
-	return false, nil
+	sort.Strings(fingerprints)
+	return received.GroupKey + "/" + strings.Join(fingerprints, ","), nil
 }
 
 type alertManagerWebhookBody struct {
-	Status string `json:"status"`
+	Status            string            `json:"status"`
+	GroupKey          string            `json:"groupKey"`
+	ExternalURL       string            `json:"externalURL"`
+	CommonLabels      map[string]string `json:"commonLabels"`
+	CommonAnnotations map[string]string `json:"commonAnnotations"`
+	Alerts            []struct {
+		Fingerprint string `json:"fingerprint"`
+	} `json:"alerts"`
 }
@@ -0,0 +1,100 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sacloud/autoscaler/inputs"
+	"github.com/sacloud/autoscaler/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInput_BuildScalingRequest(t *testing.T) {
+	base := &inputs.ScalingRequest{
+		Source:           "default",
+		ResourceName:     "default",
+		RequestType:      "up",
+		DesiredStateName: "default",
+	}
+
+	tests := []struct {
+		name    string
+		method  string
+		body    string
+		want    *inputs.ScalingRequest
+		wantErr bool
+	}{
+		{
+			name:   "firing",
+			method: http.MethodPost,
+			body: `{
+				"status": "firing",
+				"externalURL": "http://alertmanager.example.com:9093",
+				"commonLabels": {"alertname": "HighCPU", "severity": "critical"},
+				"commonAnnotations": {"summary": "CPU usage is high", "severity": "warning"}
+			}`,
+			want: &inputs.ScalingRequest{
+				Source:           "default",
+				ResourceName:     "default",
+				RequestType:      "up",
+				DesiredStateName: "default",
+				Labels: map[string]string{
+					"alertname": "HighCPU",
+					"severity":  "critical",
+					"summary":   "CPU usage is high",
+				},
+				PayloadRef: "http://alertmanager.example.com:9093",
+			},
+		},
+		{
+			name:   "firing without labels",
+			method: http.MethodPost,
+			body:   `{"status": "firing"}`,
+			want:   base,
+		},
+		{
+			name:   "resolved",
+			method: http.MethodPost,
+			body:   `{"status": "resolved", "commonLabels": {"alertname": "HighCPU"}}`,
+			want:   nil,
+		},
+		{
+			name:   "not POST",
+			method: http.MethodGet,
+			body:   `{"status": "firing"}`,
+			want:   nil,
+		},
+		{
+			name:    "invalid json",
+			method:  http.MethodPost,
+			body:    `{`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := NewInput("", "", "", test.Logger)
+			req := httptest.NewRequest(tt.method, "/up", bytes.NewReader([]byte(tt.body)))
+
+			got, err := in.BuildScalingRequest(req, base)
+			require.Equal(t, tt.wantErr, err != nil, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
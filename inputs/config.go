@@ -15,16 +15,28 @@
 package inputs
 
 import (
+	"fmt"
 	"os"
 
 	"github.com/goccy/go-yaml"
 	"github.com/sacloud/autoscaler/config"
+	"github.com/sacloud/autoscaler/validate"
 )
 
 // Config Inputsのエクスポーター関連動作設定
 type Config struct {
 	// ExporterConfig Exporterの設定
 	ExporterConfig *config.ExporterConfig `yaml:"exporter_config"`
+	// Delivery Coreへのリクエスト送信時の再送/スプール設定
+	Delivery *DeliveryConfig `yaml:"delivery"`
+	// Dedup 同一内容のリクエストの重複排除設定
+	Dedup *DedupConfig `yaml:"dedup"`
+	// RateLimit リソースごとのリクエスト数の制限設定
+	RateLimit *RateLimitConfig `yaml:"rate_limit"`
+	// Routes リクエストの内容に応じた送信先Coreの設定
+	Routes []*RouteConfig `yaml:"routes" validate:"omitempty,dive"`
+	// Wake 台数が0になったServerGroupを起こす/wakeエンドポイントの設定、省略した場合はエンドポイントを公開しない
+	Wake *WakeConfig `yaml:"wake"`
 }
 
 // LoadConfigFromPath 指定のパスからConfigをロードする
@@ -40,6 +52,22 @@ func LoadConfigFromPath(path string) (*Config, error) {
 	if err := yaml.UnmarshalWithOptions(data, c, yaml.Strict()); err != nil {
 		return nil, err
 	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
 
 	return c, nil
 }
+
+// Validate 設定値を検証する
+func (c *Config) Validate() error {
+	if err := validate.Struct(c); err != nil {
+		return err
+	}
+	for i, r := range c.Routes {
+		if err := r.Validate(); err != nil {
+			return fmt.Errorf("routes[%d]: %s", i, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datadog
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/sacloud/autoscaler/inputs"
+	"github.com/sacloud/autoscaler/version"
+)
+
+// acceptAlertTransitions 受け入れる$ALERT_TRANSITIONの値
+var acceptAlertTransitions = []string{"Triggered", "Re-Triggered"}
+
+type Input struct {
+	inputs.ScalingRequestBuilderBase
+
+	dest       string
+	addr       string
+	configPath string
+	logger     *slog.Logger
+}
+
+func NewInput(dest, addr, configPath string, logger *slog.Logger) *Input {
+	return &Input{
+		dest:       dest,
+		addr:       addr,
+		configPath: configPath,
+		logger:     logger,
+	}
+}
+
+func (in *Input) Name() string {
+	return "datadog"
+}
+
+func (in *Input) Version() string {
+	return version.FullVersion()
+}
+
+func (in *Input) Destination() string {
+	return in.dest
+}
+
+func (in *Input) ListenAddress() string {
+	return in.addr
+}
+
+func (in *Input) ConfigPath() string {
+	return in.configPath
+}
+
+func (in *Input) GetLogger() *slog.Logger {
+	return in.logger
+}
+
+// BuildScalingRequest inputs.ScalingRequestBuilderの実装
+//
+// Triggered/Re-Triggeredの通知のみ受け入れ、モニターのタグが指定されていた場合はクエリストリングの値より優先する
+func (in *Input) BuildScalingRequest(req *http.Request, base *inputs.ScalingRequest) (*inputs.ScalingRequest, error) {
+	received, err := in.parseBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if received == nil || !received.isTriggered() {
+		return nil, nil
+	}
+
+	in.logger.Info("alert accepted",
+		slog.String("alert-id", received.AlertID),
+		slog.String("alert-transition", received.AlertTransition),
+		slog.String("alert-title", received.AlertTitle),
+	)
+	tags := received.tags()
+	return base.WithTags(tags).WithLabels(tags), nil
+}
+
+func (in *Input) parseBody(req *http.Request) (*datadogWebhookBody, error) {
+	var received datadogWebhookBody
+	if ok, err := inputs.DecodeJSONBody(req, &received, http.MethodPost); !ok || err != nil {
+		return nil, err
+	}
+	return &received, nil
+}
+
+// datadogWebhookBody DatadogのWebhooksインテグレーションのペイロード
+//
+// Datadog側ではカスタムペイロードとして以下のように変数を指定しておく必要がある
+//
+//	{
+//	  "id": "$ID",
+//	  "alert_id": "$ALERT_ID",
+//	  "alert_title": "$ALERT_TITLE",
+//	  "alert_transition": "$ALERT_TRANSITION",
+//	  "alert_type": "$ALERT_TYPE",
+//	  "tags": "$TAGS"
+//	}
+//
+// see: https://docs.datadoghq.com/integrations/webhooks/
+type datadogWebhookBody struct {
+	ID              string `json:"id"`
+	AlertID         string `json:"alert_id"`
+	AlertTitle      string `json:"alert_title"`
+	AlertTransition string `json:"alert_transition"` // Triggered, Re-Triggered, Recovered, Warn, No Data, Renotifyなど
+	AlertType       string `json:"alert_type"`       // error, warning, success, info
+	Tags            string `json:"tags"`             // key:value形式をカンマ区切りで連結したもの
+}
+
+func (b *datadogWebhookBody) isTriggered() bool {
+	for _, t := range acceptAlertTransitions {
+		if t == b.AlertTransition {
+			return true
+		}
+	}
+	return false
+}
+
+// tags key:value形式のタグをmapに変換して返す、値を持たないタグは無視する
+func (b *datadogWebhookBody) tags() map[string]string {
+	tags := make(map[string]string)
+	for _, tag := range strings.Split(b.Tags, ",") {
+		key, value, found := strings.Cut(strings.TrimSpace(tag), ":")
+		if !found {
+			continue
+		}
+		tags[key] = value
+	}
+	return tags
+}
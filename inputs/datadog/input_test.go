@@ -0,0 +1,120 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datadog
+
+import (
+	"bytes"
+	_ "embed"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sacloud/autoscaler/inputs"
+	"github.com/sacloud/autoscaler/test"
+	"github.com/stretchr/testify/require"
+)
+
+//go:embed test/webhook.json
+var webhookBody []byte
+
+func TestInput_BuildScalingRequest(t *testing.T) {
+	base := &inputs.ScalingRequest{
+		Source:           "default",
+		ResourceName:     "default",
+		RequestType:      "up",
+		DesiredStateName: "default",
+	}
+
+	tests := []struct {
+		name    string
+		method  string
+		body    string
+		want    *inputs.ScalingRequest
+		wantErr bool
+	}{
+		{
+			name:   "triggered",
+			method: http.MethodPost,
+			body:   string(webhookBody),
+			want: &inputs.ScalingRequest{
+				Source:           "default",
+				ResourceName:     "web",
+				RequestType:      "up",
+				DesiredStateName: "large",
+				Labels: map[string]string{
+					"env":                           "production",
+					"host":                          "web01",
+					"autoscaler_resource_name":      "web",
+					"autoscaler_desired_state_name": "large",
+				},
+			},
+		},
+		{
+			name:   "re-triggered without tags",
+			method: http.MethodPost,
+			body:   `{"alert_transition":"Re-Triggered", "tags":""}`,
+			want:   base,
+		},
+		{
+			name:   "tags with spaces",
+			method: http.MethodPost,
+			body:   `{"alert_transition":"Triggered", "tags":"env:prod, autoscaler_request_type:down, standalone"}`,
+			want: &inputs.ScalingRequest{
+				Source:           "default",
+				ResourceName:     "default",
+				RequestType:      "up",
+				DesiredStateName: "default",
+				Labels: map[string]string{
+					"env":                     "prod",
+					"autoscaler_request_type": "down",
+				},
+			},
+		},
+		{
+			name:   "recovered",
+			method: http.MethodPost,
+			body:   `{"alert_transition":"Recovered", "tags":"autoscaler_resource_name:web"}`,
+			want:   nil,
+		},
+		{
+			name:   "warn",
+			method: http.MethodPost,
+			body:   `{"alert_transition":"Warn"}`,
+			want:   nil,
+		},
+		{
+			name:   "not POST",
+			method: http.MethodGet,
+			body:   string(webhookBody),
+			want:   nil,
+		},
+		{
+			name:    "invalid json",
+			method:  http.MethodPost,
+			body:    `{`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := NewInput("", "", "", test.Logger)
+			req := httptest.NewRequest(tt.method, "/up", bytes.NewReader([]byte(tt.body)))
+
+			got, err := in.BuildScalingRequest(req, base)
+			require.Equal(t, tt.wantErr, err != nil, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
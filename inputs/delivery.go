@@ -0,0 +1,173 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inputs
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/sacloud/autoscaler/request"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultDeliveryInitialInterval = 1  // 秒
+	defaultDeliveryMaxInterval     = 30 // 秒
+	defaultDeliveryMultiplier      = 2.0
+)
+
+// DeliveryConfig Coreへのリクエスト送信時の再送/スプール設定
+type DeliveryConfig struct {
+	// MaxRetries Coreへ接続できなかった場合の最大再送回数、0の場合は再送しない
+	MaxRetries int `yaml:"max_retries" validate:"omitempty,min=0"`
+	// InitialInterval 初回の再送までの待ち時間(秒数)、省略時は1秒
+	InitialInterval int `yaml:"initial_interval" validate:"omitempty,min=1"`
+	// MaxInterval 再送間隔の上限(秒数)、省略時は30秒
+	MaxInterval int `yaml:"max_interval" validate:"omitempty,min=1"`
+	// Multiplier 再送ごとに待ち時間へ乗じる値、省略時は2
+	Multiplier float64 `yaml:"multiplier" validate:"omitempty,gte=1"`
+	// Spool 再送しても送信できなかったリクエストをディスク上に保持する場合の設定
+	Spool *SpoolConfig `yaml:"spool"`
+}
+
+// sendFunc 指定の送信先のCoreへリクエストを1回送信する、destが空の場合はInputのDestinationへ送信する
+type sendFunc func(ctx context.Context, dest string, scalingReq *ScalingRequest) (*request.ScalingResponse, error)
+
+// delivery Coreへのリクエスト送信を再送/スプール付きで行う
+type delivery struct {
+	maxRetries      int
+	initialInterval time.Duration
+	maxInterval     time.Duration
+	multiplier      float64
+	spool           *spool
+	logger          *slog.Logger
+}
+
+func newDelivery(conf *DeliveryConfig, logger *slog.Logger) (*delivery, error) {
+	d := &delivery{
+		initialInterval: defaultDeliveryInitialInterval * time.Second,
+		maxInterval:     defaultDeliveryMaxInterval * time.Second,
+		multiplier:      defaultDeliveryMultiplier,
+		logger:          logger,
+	}
+	if conf == nil {
+		return d, nil
+	}
+
+	d.maxRetries = conf.MaxRetries
+	if conf.InitialInterval > 0 {
+		d.initialInterval = time.Duration(conf.InitialInterval) * time.Second
+	}
+	if conf.MaxInterval > 0 {
+		d.maxInterval = time.Duration(conf.MaxInterval) * time.Second
+	}
+	if conf.Multiplier > 0 {
+		d.multiplier = conf.Multiplier
+	}
+	if conf.Spool != nil {
+		s, err := newSpool(conf.Spool, logger)
+		if err != nil {
+			return nil, err
+		}
+		d.spool = s
+	}
+	return d, nil
+}
+
+// backoff attempt回目(0始まり)の再送までの待ち時間を返す
+func (d *delivery) backoff(attempt int) time.Duration {
+	wait := float64(d.initialInterval) * math.Pow(d.multiplier, float64(attempt))
+	if wait > float64(d.maxInterval) {
+		return d.maxInterval
+	}
+	return time.Duration(wait)
+}
+
+// sendWithRetry Coreへ接続できなかった場合にmaxRetriesまで再送する
+func (d *delivery) sendWithRetry(ctx context.Context, dest string, scalingReq *ScalingRequest, send sendFunc) (*request.ScalingResponse, error) {
+	for attempt := 0; ; attempt++ {
+		res, err := send(ctx, dest, scalingReq)
+		if err == nil || !isRetriable(err) || attempt >= d.maxRetries {
+			return res, err
+		}
+
+		wait := d.backoff(attempt)
+		d.logger.Warn(
+			"sending request to the Core server failed, retrying",
+			slog.Any("error", err),
+			slog.String("destination", dest),
+			slog.Int("attempt", attempt+1),
+			slog.Duration("wait", wait),
+		)
+		retryCounter.WithLabelValues(scalingReq.RequestType).Inc()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// deliver 再送しても送信できなかった場合、スプールが有効であればリクエストをスプールに保存する
+//
+// スプールに保存した場合はspooledにtrueを返す。
+// ctxがキャンセルされて送信を中断した場合はスプールに保存せずにエラーを返す
+func (d *delivery) deliver(ctx context.Context, dest string, scalingReq *ScalingRequest, send sendFunc) (res *request.ScalingResponse, spooled bool, err error) {
+	res, err = d.sendWithRetry(ctx, dest, scalingReq, send)
+	if err == nil || d.spool == nil || !isRetriable(err) {
+		return res, false, err
+	}
+	if ctx.Err() != nil {
+		return nil, false, ctx.Err()
+	}
+
+	d.logger.Warn("the Core server is unreachable, spooling request", slog.Any("error", err), slog.String("destination", dest))
+	if err := d.spool.put(dest, scalingReq); err != nil {
+		return nil, false, err
+	}
+	return nil, true, nil
+}
+
+// unavailableError Coreへの接続自体ができなかったことを示すエラー
+type unavailableError struct {
+	err error
+}
+
+func (e *unavailableError) Error() string {
+	return e.err.Error()
+}
+
+func (e *unavailableError) Unwrap() error {
+	return e.err
+}
+
+// isRetriable Coreの再起動中などで接続できなかった場合にtrueを返す
+//
+// Coreがリクエストを処理した上でエラーを返した場合は再送しても結果が変わらないためfalseを返す
+func isRetriable(err error) bool {
+	var ue *unavailableError
+	if errors.As(err, &ue) {
+		return true
+	}
+	if st, ok := status.FromError(err); ok {
+		return st.Code() == codes.Unavailable
+	}
+	return false
+}
@@ -0,0 +1,178 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inputs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sacloud/autoscaler/request"
+	"github.com/sacloud/autoscaler/test"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeSend 先頭からerrsの内容を順に返し、errsを使い切ったら成功する
+type fakeSend struct {
+	errs     []error
+	received []*ScalingRequest
+}
+
+func (f *fakeSend) send(_ context.Context, _ string, scalingReq *ScalingRequest) (*request.ScalingResponse, error) {
+	f.received = append(f.received, scalingReq)
+	if len(f.errs) > 0 {
+		err := f.errs[0]
+		f.errs = f.errs[1:]
+		return nil, err
+	}
+	return &request.ScalingResponse{ScalingJobId: "1", Status: request.ScalingJobStatus_JOB_DONE}, nil
+}
+
+func Test_delivery_backoff(t *testing.T) {
+	d := &delivery{
+		initialInterval: time.Second,
+		maxInterval:     5 * time.Second,
+		multiplier:      2,
+	}
+	require.Equal(t, time.Second, d.backoff(0))
+	require.Equal(t, 2*time.Second, d.backoff(1))
+	require.Equal(t, 4*time.Second, d.backoff(2))
+	require.Equal(t, 5*time.Second, d.backoff(3))
+}
+
+func Test_delivery_sendWithRetry(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "connection refused")
+
+	tests := []struct {
+		name       string
+		maxRetries int
+		errs       []error
+		wantCalls  int
+		wantErr    bool
+	}{
+		{
+			name:       "succeeded",
+			maxRetries: 2,
+			wantCalls:  1,
+		},
+		{
+			name:       "succeeded after retry",
+			maxRetries: 2,
+			errs:       []error{unavailable, &unavailableError{err: errors.New("socket not found")}},
+			wantCalls:  3,
+		},
+		{
+			name:       "max retries exceeded",
+			maxRetries: 1,
+			errs:       []error{unavailable, unavailable},
+			wantCalls:  2,
+			wantErr:    true,
+		},
+		{
+			name:       "not retriable",
+			maxRetries: 2,
+			errs:       []error{status.Error(codes.Unknown, "resource not found")},
+			wantCalls:  1,
+			wantErr:    true,
+		},
+		{
+			name:       "retry disabled",
+			maxRetries: 0,
+			errs:       []error{unavailable},
+			wantCalls:  1,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &delivery{
+				maxRetries:      tt.maxRetries,
+				initialInterval: time.Millisecond,
+				maxInterval:     time.Millisecond,
+				multiplier:      2,
+				logger:          test.Logger,
+			}
+			f := &fakeSend{errs: tt.errs}
+			_, err := d.sendWithRetry(context.Background(), "", &ScalingRequest{RequestType: "up"}, f.send)
+			require.Equal(t, tt.wantErr, err != nil, err)
+			require.Len(t, f.received, tt.wantCalls)
+		})
+	}
+}
+
+func Test_delivery_deliver(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "connection refused")
+
+	tests := []struct {
+		name        string
+		errs        []error
+		canceled    bool
+		wantSpooled bool
+		wantErr     bool
+	}{
+		{
+			name: "succeeded",
+		},
+		{
+			name:        "spooled",
+			errs:        []error{unavailable},
+			wantSpooled: true,
+		},
+		{
+			name:    "not retriable",
+			errs:    []error{status.Error(codes.Unknown, "resource not found")},
+			wantErr: true,
+		},
+		{
+			name:     "canceled",
+			errs:     []error{unavailable},
+			canceled: true,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.canceled {
+				cancel()
+			}
+			s, err := newSpool(&SpoolConfig{Dir: t.TempDir()}, test.Logger)
+			if err != nil {
+				t.Fatal(err)
+			}
+			d := &delivery{spool: s, logger: test.Logger}
+			f := &fakeSend{errs: tt.errs}
+
+			res, spooled, err := d.deliver(ctx, "", &ScalingRequest{RequestType: "up"}, f.send)
+			require.Equal(t, tt.wantErr, err != nil, err)
+			require.Equal(t, tt.wantSpooled, spooled)
+			require.Equal(t, !tt.wantErr && !tt.wantSpooled, res != nil)
+
+			names, err := s.entries()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.wantSpooled {
+				require.Len(t, names, 1)
+			} else {
+				require.Empty(t, names)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/goccy/go-yaml"
+	"github.com/sacloud/autoscaler/validate"
+)
+
+const defaultInterval = 5
+
+// Config ファイル/標準入力から読み取った値に対する閾値ルールの設定
+type Config struct {
+	// Interval ファイルを読み取る間隔(秒数)、省略時は5秒。標準入力から読み取る場合は利用されない
+	Interval int `yaml:"interval" validate:"omitempty,min=1"`
+	// Rules 閾値ルールのリスト
+	Rules []*Rule `yaml:"rules" validate:"required,min=1,dive"`
+}
+
+// Rule 読み取った値と閾値の比較と、その結果に応じたリクエスト内容の定義
+//
+// 値がUpperより大きい場合はUp、Lowerより小さい場合はDownをCoreへリクエストする。
+// リクエスト後Cooldown秒の間は同じルールでのリクエストを行わない
+type Rule struct {
+	// Name ルール名、ログ出力やクールダウンの管理に利用するためルール間で一意である必要がある
+	Name string `yaml:"name" validate:"required"`
+	// Field 値を取り出すJSONオブジェクトのキー、ネストしている場合は.で区切る
+	//
+	// 省略時は読み取った内容全体を数値として扱う
+	Field string `yaml:"field"`
+
+	// Upper 上限値
+	Upper *float64 `yaml:"upper"`
+	// Lower 下限値
+	Lower *float64 `yaml:"lower"`
+	// SendKeep 値がUpper/Lowerの範囲内の場合にKeepをリクエストするか
+	SendKeep bool `yaml:"send_keep"`
+	// Cooldown リクエスト後、次のリクエストを行うまでの待ち時間(秒数)
+	Cooldown int `yaml:"cooldown" validate:"omitempty,min=0"`
+
+	Source           string `yaml:"source" validate:"omitempty,printascii,max=1024"`
+	ResourceName     string `yaml:"resource_name" validate:"omitempty,printascii,max=1024"`
+	DesiredStateName string `yaml:"desired_state_name" validate:"omitempty,printascii,max=1024"`
+	Step             uint32 `yaml:"step"`
+}
+
+// LoadConfigFromPath 指定のパスからConfigをロードする
+func LoadConfigFromPath(path string) (*Config, error) {
+	data, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+	c := &Config{}
+	if err := yaml.UnmarshalWithOptions(data, c, yaml.Strict()); err != nil {
+		return nil, err
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Validate 設定値を検証する
+func (c *Config) Validate() error {
+	if err := validate.Struct(c); err != nil {
+		return err
+	}
+	names := make(map[string]struct{})
+	for _, r := range c.Rules {
+		if _, ok := names[r.Name]; ok {
+			return fmt.Errorf("rule %q: name must be unique", r.Name)
+		}
+		names[r.Name] = struct{}{}
+		if r.Upper == nil && r.Lower == nil {
+			return fmt.Errorf("rule %q: upper or lower is required", r.Name)
+		}
+		if r.Upper != nil && r.Lower != nil && *r.Lower > *r.Upper {
+			return fmt.Errorf("rule %q: lower must be less than or equal to upper", r.Name)
+		}
+	}
+	return nil
+}
+
+func (c *Config) interval() time.Duration {
+	if c.Interval > 0 {
+		return time.Duration(c.Interval) * time.Second
+	}
+	return defaultInterval * time.Second
+}
+
+func (r *Rule) cooldown() time.Duration {
+	return time.Duration(r.Cooldown) * time.Second
+}
@@ -0,0 +1,288 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sacloud/autoscaler/defaults"
+	"github.com/sacloud/autoscaler/inputs"
+	"github.com/sacloud/autoscaler/version"
+)
+
+// errFieldNotFound レコードにルールのFieldが含まれない
+var errFieldNotFound = errors.New("field not found")
+
+// Input ファイルまたは標準入力から読み取った値を元にCoreへのリクエストを行うInput
+//
+// Webhookは受け付けずinputs.Pollerとして動作する
+type Input struct {
+	dest       string
+	configPath string
+	logger     *slog.Logger
+
+	path   string
+	stdin  io.Reader
+	config *Config
+
+	mu       sync.Mutex
+	lastSent map[string]time.Time // key: ルール名
+	now      func() time.Time
+}
+
+// NewInput file Inputを作成する
+//
+// pathが空の場合はstdinから1行1レコードのJSON(NDJSON)を読み取る
+func NewInput(dest, configPath string, logger *slog.Logger, path string, stdin io.Reader, config *Config) *Input {
+	return &Input{
+		dest:       dest,
+		configPath: configPath,
+		logger:     logger,
+
+		path:     path,
+		stdin:    stdin,
+		config:   config,
+		lastSent: make(map[string]time.Time),
+		now:      time.Now,
+	}
+}
+
+func (in *Input) Name() string {
+	return "file"
+}
+
+func (in *Input) Version() string {
+	return version.FullVersion()
+}
+
+func (in *Input) Destination() string {
+	return in.dest
+}
+
+// ListenAddress Webhookを受け付けないため空文字を返す
+func (in *Input) ListenAddress() string {
+	return ""
+}
+
+func (in *Input) ConfigPath() string {
+	return in.configPath
+}
+
+func (in *Input) GetLogger() *slog.Logger {
+	return in.logger
+}
+
+// ShouldAccept Webhookは受け付けないため常にfalseを返す
+func (in *Input) ShouldAccept(*http.Request) (bool, error) {
+	return false, nil
+}
+
+// Poll inputs.Pollerの実装
+//
+// ファイルを読み取る場合はctxがキャンセルされるまで、標準入力から読み取る場合は終端に達するまでブロックする
+func (in *Input) Poll(ctx context.Context, sender inputs.Sender) error {
+	if in.path != "" {
+		return in.watchFile(ctx, sender)
+	}
+	return in.readStream(ctx, sender)
+}
+
+func (in *Input) watchFile(ctx context.Context, sender inputs.Sender) error {
+	ticker := time.NewTicker(in.config.interval())
+	defer ticker.Stop()
+
+	in.logger.Info("watching file started", slog.String("path", in.path), slog.Duration("interval", in.config.interval()))
+	for {
+		data, err := os.ReadFile(in.path)
+		if err != nil {
+			in.logger.Error("reading file failed", slog.String("path", in.path), slog.Any("error", err))
+		} else {
+			in.evaluateAll(ctx, sender, data, false)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (in *Input) readStream(ctx context.Context, sender inputs.Sender) error {
+	in.logger.Info("reading stdin started")
+
+	scanner := bufio.NewScanner(in.stdin)
+	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		line := scanner.Bytes()
+		if len(strings.TrimSpace(string(line))) == 0 {
+			continue
+		}
+		in.evaluateAll(ctx, sender, line, true)
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	in.logger.Info("reached the end of stdin")
+	return nil
+}
+
+// evaluateAll 1レコード分のデータに対し全てのルールを評価する
+//
+// skipMissingがtrueの場合、Fieldを含まないレコードはそのルールの対象外として扱う
+func (in *Input) evaluateAll(ctx context.Context, sender inputs.Sender, data []byte, skipMissing bool) {
+	var record interface{}
+	if err := json.Unmarshal(data, &record); err != nil {
+		in.logger.Error("parsing record failed", slog.Any("error", err))
+		return
+	}
+
+	for _, rule := range in.config.Rules {
+		value, err := extractValue(record, rule.Field)
+		if err != nil {
+			if skipMissing && errors.Is(err, errFieldNotFound) {
+				continue
+			}
+			in.logger.Error("extracting value failed", slog.String("rule", rule.Name), slog.Any("error", err))
+			continue
+		}
+		if err := in.evaluate(ctx, sender, rule, value); err != nil {
+			in.logger.Error("evaluating rule failed", slog.String("rule", rule.Name), slog.Any("error", err))
+		}
+	}
+}
+
+func (in *Input) evaluate(ctx context.Context, sender inputs.Sender, rule *Rule, value float64) error {
+	requestType := in.requestTypeFor(rule, value)
+	if requestType == "" {
+		return nil
+	}
+
+	scalingReq := &inputs.ScalingRequest{
+		Source:           rule.Source,
+		ResourceName:     rule.ResourceName,
+		RequestType:      requestType,
+		DesiredStateName: rule.DesiredStateName,
+		Step:             rule.Step,
+	}
+	if scalingReq.Source == "" {
+		scalingReq.Source = defaults.SourceName
+	}
+	if scalingReq.ResourceName == "" {
+		scalingReq.ResourceName = defaults.ResourceName
+	}
+	if scalingReq.DesiredStateName == "" {
+		scalingReq.DesiredStateName = defaults.DesiredStateName
+	}
+
+	in.logger.Info("sending request to the Core server",
+		slog.String("rule", rule.Name),
+		slog.String("request-type", requestType),
+		slog.Float64("value", value),
+	)
+	res, err := sender.Send(ctx, scalingReq)
+	if err != nil {
+		var dropped *inputs.DroppedError
+		if errors.As(err, &dropped) {
+			in.logger.Info("request dropped", slog.String("rule", rule.Name), slog.String("reason", dropped.Reason))
+			return nil
+		}
+		return err
+	}
+	in.markSent(rule)
+
+	in.logger.Info("request handled",
+		slog.String("rule", rule.Name),
+		slog.String("status", res.Status.String()),
+		slog.String("job-id", res.ScalingJobId),
+		slog.String("job-message", res.Message),
+	)
+	return nil
+}
+
+// requestTypeFor 値からリクエスト種別を判定する、リクエスト不要な場合やクールダウン中の場合は空文字を返す
+func (in *Input) requestTypeFor(rule *Rule, value float64) string {
+	requestType := ""
+	switch {
+	case rule.Upper != nil && value > *rule.Upper:
+		requestType = "up"
+	case rule.Lower != nil && value < *rule.Lower:
+		requestType = "down"
+	case rule.SendKeep:
+		requestType = "keep"
+	}
+	if requestType == "" {
+		return ""
+	}
+
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	if last, ok := in.lastSent[rule.Name]; ok && in.now().Sub(last) < rule.cooldown() {
+		in.logger.Debug("in cooldown period", slog.String("rule", rule.Name), slog.String("request-type", requestType))
+		return ""
+	}
+	return requestType
+}
+
+func (in *Input) markSent(rule *Rule) {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	in.lastSent[rule.Name] = in.now()
+}
+
+// extractValue レコードからfieldで指定された数値を取り出す
+func extractValue(record interface{}, field string) (float64, error) {
+	v := record
+	if field != "" {
+		for _, key := range strings.Split(field, ".") {
+			obj, ok := v.(map[string]interface{})
+			if !ok {
+				return 0, fmt.Errorf("%w: %s", errFieldNotFound, field)
+			}
+			v, ok = obj[key]
+			if !ok {
+				return 0, fmt.Errorf("%w: %s", errFieldNotFound, field)
+			}
+		}
+	}
+
+	switch v := v.(type) {
+	case float64:
+		return v, nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return 0, fmt.Errorf("value is not a number: %q", v)
+		}
+		return f, nil
+	default:
+		return 0, fmt.Errorf("value is not a number: %v", v)
+	}
+}
@@ -0,0 +1,271 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sacloud/autoscaler/grpcutil"
+	"github.com/sacloud/autoscaler/inputs"
+	"github.com/sacloud/autoscaler/request"
+	"github.com/sacloud/autoscaler/test"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeSender struct {
+	mu       sync.Mutex
+	received []*inputs.ScalingRequest
+}
+
+func (s *fakeSender) Send(_ context.Context, req *inputs.ScalingRequest) (*request.ScalingResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.received = append(s.received, req)
+	return &request.ScalingResponse{ScalingJobId: "1", Status: request.ScalingJobStatus_JOB_ACCEPTED}, nil
+}
+
+func (s *fakeSender) requests() []*inputs.ScalingRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.received
+}
+
+func TestInput_evaluateAll(t *testing.T) {
+	config, err := LoadConfigFromPath("test/config.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	upWorker := &inputs.ScalingRequest{Source: "default", ResourceName: "worker", RequestType: "up", DesiredStateName: "default"}
+	downWorker := &inputs.ScalingRequest{Source: "default", ResourceName: "worker", RequestType: "down", DesiredStateName: "default"}
+	upWeb := &inputs.ScalingRequest{Source: "default", ResourceName: "web", RequestType: "up", DesiredStateName: "default", Step: 2}
+
+	tests := []struct {
+		name    string
+		lines   []string
+		elapsed []time.Duration
+		want    []*inputs.ScalingRequest
+	}{
+		{
+			name:    "over upper",
+			lines:   []string{`{"queue": {"length": 150}}`},
+			elapsed: []time.Duration{0},
+			want:    []*inputs.ScalingRequest{upWorker},
+		},
+		{
+			name:    "within range",
+			lines:   []string{`{"queue": {"length": 50}}`},
+			elapsed: []time.Duration{0},
+			want:    nil,
+		},
+		{
+			name:    "in cooldown",
+			lines:   []string{`{"queue": {"length": 150}}`, `{"queue": {"length": 5}}`, `{"queue": {"length": 5}}`},
+			elapsed: []time.Duration{0, 30 * time.Second, 61 * time.Second},
+			want:    []*inputs.ScalingRequest{upWorker, downWorker},
+		},
+		{
+			name:    "multiple rules and missing fields",
+			lines:   []string{`{"cpu": "90"}`, ``, `{"queue": {"length": 150}, "cpu": 10}`},
+			elapsed: []time.Duration{0, 0, 0},
+			want:    []*inputs.ScalingRequest{upWeb, upWorker},
+		},
+		{
+			name:    "invalid lines are skipped",
+			lines:   []string{`{`, `{"cpu": "high"}`, `{"cpu": 90}`},
+			elapsed: []time.Duration{0, 0, 0},
+			want:    []*inputs.ScalingRequest{upWeb},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+			line := 0
+			in := NewInput("", "", test.Logger, "", nil, config)
+			in.now = func() time.Time {
+				// 各行の評価時点の時刻を返す
+				return now.Add(tt.elapsed[min(line, len(tt.elapsed)-1)])
+			}
+			sender := &fakeSender{}
+
+			// 1行ずつ評価して時刻を進める
+			for i, l := range tt.lines {
+				line = i
+				if strings.TrimSpace(l) == "" {
+					continue
+				}
+				in.evaluateAll(context.Background(), sender, []byte(l), true)
+			}
+			require.Equal(t, tt.want, sender.requests())
+		})
+	}
+}
+
+func TestInput_Poll_stdin(t *testing.T) {
+	config, err := LoadConfigFromPath("test/config.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdin := strings.NewReader(`{"cpu": 90}` + "\n" + `{"queue": {"length": 150}}` + "\n")
+	in := NewInput("", "", test.Logger, "", stdin, config)
+	sender := &fakeSender{}
+
+	// 標準入力の終端に達したらnilを返す
+	require.NoError(t, in.Poll(context.Background(), sender))
+	require.Equal(t, []*inputs.ScalingRequest{
+		{Source: "default", ResourceName: "web", RequestType: "up", DesiredStateName: "default", Step: 2},
+		{Source: "default", ResourceName: "worker", RequestType: "up", DesiredStateName: "default"},
+	}, sender.requests())
+}
+
+func TestInput_Poll_file(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "queue.txt")
+	if err := os.WriteFile(path, []byte("150\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	config := &Config{
+		Interval: 1,
+		Rules:    []*Rule{{Name: "queue", Upper: ptr(100), Cooldown: 60, ResourceName: "worker"}},
+	}
+	in := NewInput("", "", test.Logger, path, nil, config)
+	sender := &fakeSender{}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1500*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, in.Poll(ctx, sender), context.DeadlineExceeded)
+
+	// 2回読み取るがクールダウン中のため1回のみリクエストされる
+	require.Equal(t, []*inputs.ScalingRequest{
+		{Source: "default", ResourceName: "worker", RequestType: "up", DesiredStateName: "default"},
+	}, sender.requests())
+}
+
+// rejectingScalingService リクエストを全て拒否するCore
+type rejectingScalingService struct {
+	request.UnimplementedScalingServiceServer
+
+	mu       sync.Mutex
+	received []string
+}
+
+func (s *rejectingScalingService) Up(_ context.Context, req *request.ScalingRequest) (*request.ScalingResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.received = append(s.received, req.ResourceName)
+	return nil, status.Error(codes.InvalidArgument, "rejected")
+}
+
+func (s *rejectingScalingService) requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.received
+}
+
+func TestServe_stdin(t *testing.T) {
+	grpcServer, listener, cleanup, err := grpcutil.Server(&grpcutil.ListenerOption{Address: "localhost:0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	core := &rejectingScalingService{}
+	request.RegisterScalingServiceServer(grpcServer, core)
+	go grpcServer.Serve(listener) //nolint:errcheck
+	defer func() {
+		grpcServer.GracefulStop()
+		cleanup()
+	}()
+
+	// 前回起動時に送信できなかったリクエスト
+	spoolDir := t.TempDir()
+	spooled := fmt.Sprintf(`{"created_at": %q, "request": {"ResourceName": "spooled", "RequestType": "up"}}`, time.Now().Format(time.RFC3339))
+	if err := os.WriteFile(filepath.Join(spoolDir, "00000000000000000001-000001.json"), []byte(spooled), 0600); err != nil {
+		t.Fatal(err)
+	}
+	inputsConfig := filepath.Join(t.TempDir(), "inputs.yaml")
+	if err := os.WriteFile(inputsConfig, []byte(fmt.Sprintf("delivery:\n  spool:\n    dir: %q\n    flush_interval: 3600\n", spoolDir)), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := LoadConfigFromPath("test/config.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdin := strings.NewReader(`{"cpu": 90}` + "\n" + `{"queue": {"length": 150}}` + "\n")
+	in := NewInput(listener.Addr().String(), inputsConfig, test.Logger, "", stdin, config)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// 標準入力の終端に達したら、スプールを送信してから終了する
+	require.NoError(t, inputs.Serve(ctx, in))
+	require.Equal(t, []string{"web", "worker", "spooled"}, core.requests())
+
+	entries, err := os.ReadDir(spoolDir)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *Config
+		wantErr bool
+	}{
+		{
+			name:   "valid",
+			config: &Config{Rules: []*Rule{{Name: "queue", Upper: ptr(100), Lower: ptr(10)}}},
+		},
+		{
+			name:    "empty rules",
+			config:  &Config{},
+			wantErr: true,
+		},
+		{
+			name:    "without thresholds",
+			config:  &Config{Rules: []*Rule{{Name: "queue"}}},
+			wantErr: true,
+		},
+		{
+			name:    "lower is greater than upper",
+			config:  &Config{Rules: []*Rule{{Name: "queue", Upper: ptr(10), Lower: ptr(100)}}},
+			wantErr: true,
+		},
+		{
+			name: "duplicated rule name",
+			config: &Config{Rules: []*Rule{
+				{Name: "queue", Field: "queue.length", Upper: ptr(100)},
+				{Name: "queue", Field: "queue.size", Upper: ptr(100)},
+			}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			require.Equal(t, tt.wantErr, err != nil, err)
+		})
+	}
+}
+
+func ptr(v float64) *float64 {
+	return &v
+}
@@ -0,0 +1,165 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generic
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/goccy/go-yaml"
+	"github.com/google/cel-go/cel"
+	"github.com/sacloud/autoscaler/validate"
+)
+
+// Config Webhookの受け入れルールの設定
+type Config struct {
+	// Rules 受け入れルールのリスト、上から順に評価され最初にConditionを満たしたものが利用される
+	Rules []*Rule `yaml:"rules" validate:"required,min=1,dive"`
+}
+
+// Rule CELで記述されたWebhookの受け入れ条件とリクエストパラメータの算出式
+//
+// 各式では以下の変数を参照できる
+//
+//   - body: リクエストボディをJSONとしてパースした値(JSONでない場合はnull)
+//   - headers: リクエストヘッダ(キーは小文字、値は先頭のもの)
+//   - method: HTTPメソッド
+//   - path: URLのパス
+//   - query: クエリストリング(値は先頭のもの)
+//   - request_type: URLのパスから決定したリクエスト種別(upまたはdown)
+//
+// Condition以外の式は省略可能で、省略した場合はURLのパスやクエリストリングから決定した値が利用される
+type Rule struct {
+	// Name ルール名、ログ出力などに利用する
+	Name string `yaml:"name" validate:"required"`
+	// Condition 受け入れ条件、boolを返す式
+	Condition string `yaml:"condition" validate:"required"`
+
+	// RequestType リクエスト種別(up/down/keep)を返す式
+	RequestType string `yaml:"request_type"`
+	// Source 呼び出し元を示す文字列を返す式
+	Source string `yaml:"source"`
+	// ResourceName リソース名を返す式
+	ResourceName string `yaml:"resource_name"`
+	// DesiredStateName DesiredStateNameを返す式
+	DesiredStateName string `yaml:"desired_state_name"`
+	// Step 一度に変更するプランの段階数を返す式
+	Step string `yaml:"step"`
+
+	programs *rulePrograms
+}
+
+type rulePrograms struct {
+	condition        cel.Program
+	requestType      cel.Program
+	source           cel.Program
+	resourceName     cel.Program
+	desiredStateName cel.Program
+	step             cel.Program
+}
+
+// LoadConfigFromPath 指定のパスからConfigをロードし、各ルールの式をコンパイルする
+func LoadConfigFromPath(path string) (*Config, error) {
+	data, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+	c := &Config{}
+	if err := yaml.UnmarshalWithOptions(data, c, yaml.Strict()); err != nil {
+		return nil, err
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Validate 設定値を検証し、各ルールの式をコンパイルする
+func (c *Config) Validate() error {
+	if err := validate.Struct(c); err != nil {
+		return err
+	}
+	env, err := newEnv()
+	if err != nil {
+		return err
+	}
+	for _, rule := range c.Rules {
+		if err := rule.compile(env); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newEnv() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable("body", cel.DynType),
+		cel.Variable("headers", cel.MapType(cel.StringType, cel.StringType)),
+		cel.Variable("method", cel.StringType),
+		cel.Variable("path", cel.StringType),
+		cel.Variable("query", cel.MapType(cel.StringType, cel.StringType)),
+		cel.Variable("request_type", cel.StringType),
+		cel.CrossTypeNumericComparisons(true),
+	)
+}
+
+func (r *Rule) compile(env *cel.Env) error {
+	programs := &rulePrograms{}
+	targets := []struct {
+		field    string
+		expr     string
+		dest     *cel.Program
+		outTypes []*cel.Type
+	}{
+		{field: "condition", expr: r.Condition, dest: &programs.condition, outTypes: []*cel.Type{cel.BoolType}},
+		{field: "request_type", expr: r.RequestType, dest: &programs.requestType, outTypes: []*cel.Type{cel.StringType}},
+		{field: "source", expr: r.Source, dest: &programs.source, outTypes: []*cel.Type{cel.StringType}},
+		{field: "resource_name", expr: r.ResourceName, dest: &programs.resourceName, outTypes: []*cel.Type{cel.StringType}},
+		{field: "desired_state_name", expr: r.DesiredStateName, dest: &programs.desiredStateName, outTypes: []*cel.Type{cel.StringType}},
+		{field: "step", expr: r.Step, dest: &programs.step, outTypes: []*cel.Type{cel.IntType, cel.UintType}},
+	}
+	for _, t := range targets {
+		if t.expr == "" {
+			continue
+		}
+		ast, iss := env.Compile(t.expr)
+		if iss.Err() != nil {
+			return fmt.Errorf("rule %q: %s: %s", r.Name, t.field, iss.Err())
+		}
+		if !acceptableOutputType(ast.OutputType(), t.outTypes) {
+			return fmt.Errorf("rule %q: %s: expression must return %s, but got %s", r.Name, t.field, t.outTypes[0], ast.OutputType())
+		}
+		program, err := env.Program(ast)
+		if err != nil {
+			return fmt.Errorf("rule %q: %s: %s", r.Name, t.field, err)
+		}
+		*t.dest = program
+	}
+	r.programs = programs
+	return nil
+}
+
+// acceptableOutputType 式の型がtypesのいずれかに該当するか、bodyを参照するなどでdynの場合にtrueを返す
+func acceptableOutputType(outType *cel.Type, types []*cel.Type) bool {
+	if outType.IsExactType(cel.DynType) {
+		return true
+	}
+	for _, t := range types {
+		if outType.IsExactType(t) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,228 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generic
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"net/http"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/sacloud/autoscaler/inputs"
+	"github.com/sacloud/autoscaler/version"
+)
+
+// Input 設定ファイルで定義したCELのルールに応じてWebhookを受け入れるInput
+type Input struct {
+	dest       string
+	addr       string
+	configPath string
+	logger     *slog.Logger
+
+	config *Config
+}
+
+func NewInput(dest, addr, configPath string, logger *slog.Logger, config *Config) *Input {
+	return &Input{
+		dest:       dest,
+		addr:       addr,
+		configPath: configPath,
+		logger:     logger,
+		config:     config,
+	}
+}
+
+func (in *Input) Name() string {
+	return "generic"
+}
+
+func (in *Input) Version() string {
+	return version.FullVersion()
+}
+
+func (in *Input) Destination() string {
+	return in.dest
+}
+
+func (in *Input) ListenAddress() string {
+	return in.addr
+}
+
+func (in *Input) ConfigPath() string {
+	return in.configPath
+}
+
+func (in *Input) GetLogger() *slog.Logger {
+	return in.logger
+}
+
+func (in *Input) ShouldAccept(req *http.Request) (bool, error) {
+	vars, err := in.variables(req, "")
+	if err != nil {
+		return false, err
+	}
+	return in.findRule(vars) != nil, nil
+}
+
+// BuildScalingRequest inputs.ScalingRequestBuilderの実装
+//
+// Conditionを満たした最初のルールの式でリクエストパラメータを算出する
+func (in *Input) BuildScalingRequest(req *http.Request, base *inputs.ScalingRequest) (*inputs.ScalingRequest, error) {
+	vars, err := in.variables(req, base.RequestType)
+	if err != nil {
+		return nil, err
+	}
+	rule := in.findRule(vars)
+	if rule == nil {
+		return nil, nil
+	}
+
+	scalingReq := *base
+	stringFields := []struct {
+		program cel.Program
+		dest    *string
+		field   string
+	}{
+		{program: rule.programs.requestType, dest: &scalingReq.RequestType, field: "request_type"},
+		{program: rule.programs.source, dest: &scalingReq.Source, field: "source"},
+		{program: rule.programs.resourceName, dest: &scalingReq.ResourceName, field: "resource_name"},
+		{program: rule.programs.desiredStateName, dest: &scalingReq.DesiredStateName, field: "desired_state_name"},
+	}
+	for _, f := range stringFields {
+		if f.program == nil {
+			continue
+		}
+		v, err := evalString(f.program, vars)
+		if err != nil {
+			return nil, fmt.Errorf("rule %q: %s: %s", rule.Name, f.field, err)
+		}
+		if v != "" {
+			*f.dest = v
+		}
+	}
+	if rule.programs.step != nil {
+		v, err := evalStep(rule.programs.step, vars)
+		if err != nil {
+			return nil, fmt.Errorf("rule %q: step: %s", rule.Name, err)
+		}
+		scalingReq.Step = v
+	}
+
+	in.logger.Info("rule matched", slog.String("rule", rule.Name))
+	return &scalingReq, nil
+}
+
+func (in *Input) variables(req *http.Request, requestType string) (map[string]interface{}, error) {
+	reqData, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	var body interface{}
+	if len(reqData) > 0 {
+		if err := json.Unmarshal(reqData, &body); err != nil {
+			in.logger.Debug("request body is not a JSON", slog.Any("error", err))
+			body = nil
+		}
+	}
+
+	headers := make(map[string]string)
+	for k, v := range req.Header {
+		if len(v) > 0 {
+			headers[strings.ToLower(k)] = v[0]
+		}
+	}
+	query := make(map[string]string)
+	for k, v := range req.URL.Query() {
+		if len(v) > 0 {
+			query[k] = v[0]
+		}
+	}
+
+	return map[string]interface{}{
+		"body":         body,
+		"headers":      headers,
+		"method":       req.Method,
+		"path":         req.URL.Path,
+		"query":        query,
+		"request_type": requestType,
+	}, nil
+}
+
+// findRule Conditionを満たす最初のルールを返す
+//
+// Conditionの評価時のエラー(存在しないキーの参照など)はマッチしなかったものとして扱う
+func (in *Input) findRule(vars map[string]interface{}) *Rule {
+	for _, rule := range in.config.Rules {
+		out, _, err := rule.programs.condition.Eval(vars)
+		if err != nil {
+			in.logger.Debug("evaluating condition failed", slog.String("rule", rule.Name), slog.Any("error", err))
+			continue
+		}
+		if matched, ok := out.Value().(bool); ok && matched {
+			return rule
+		}
+	}
+	return nil
+}
+
+func evalString(program cel.Program, vars map[string]interface{}) (string, error) {
+	out, _, err := program.Eval(vars)
+	if err != nil {
+		return "", err
+	}
+	v, ok := out.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("expression must return string, but got %s", out.Type())
+	}
+	return v, nil
+}
+
+func evalStep(program cel.Program, vars map[string]interface{}) (uint32, error) {
+	out, _, err := program.Eval(vars)
+	if err != nil {
+		return 0, err
+	}
+	return toUint32(out)
+}
+
+// toUint32 式の評価結果を段階数に変換する
+//
+// JSONの数値はdoubleとして評価されるため、小数部を持たない場合に限りdoubleも受け付ける
+func toUint32(v ref.Val) (uint32, error) {
+	var n float64
+	switch v := v.(type) {
+	case types.Int:
+		n = float64(v)
+	case types.Uint:
+		n = float64(v)
+	case types.Double:
+		n = float64(v)
+		if n != math.Trunc(n) {
+			return 0, fmt.Errorf("expression must return an integral value, but got %v", n)
+		}
+	default:
+		return 0, fmt.Errorf("expression must return int, uint or integral double, but got %s", v.Type())
+	}
+	if n < 0 || n > float64(^uint32(0)) {
+		return 0, fmt.Errorf("invalid step: %v", n)
+	}
+	return uint32(n), nil
+}
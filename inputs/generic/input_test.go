@@ -0,0 +1,201 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generic
+
+import (
+	"bytes"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/sacloud/autoscaler/inputs"
+	"github.com/sacloud/autoscaler/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    *Rule
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			rule: &Rule{Name: "valid", Condition: `body.state == "alerting"`, ResourceName: `body.name`, Step: `2`},
+		},
+		{
+			name:    "syntax error",
+			rule:    &Rule{Name: "invalid", Condition: `body.state ==`},
+			wantErr: true,
+		},
+		{
+			name:    "condition returns non-bool",
+			rule:    &Rule{Name: "invalid", Condition: `"true"`},
+			wantErr: true,
+		},
+		{
+			name:    "resource_name returns non-string",
+			rule:    &Rule{Name: "invalid", Condition: `true`, ResourceName: `1`},
+			wantErr: true,
+		},
+		{
+			name:    "unknown variable",
+			rule:    &Rule{Name: "invalid", Condition: `foo == "bar"`},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Config{Rules: []*Rule{tt.rule}}
+			err := c.Validate()
+			require.Equal(t, tt.wantErr, err != nil, err)
+		})
+	}
+}
+
+func TestInput_BuildScalingRequest(t *testing.T) {
+	config, err := LoadConfigFromPath("test/rules.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	base := &inputs.ScalingRequest{
+		Source:           "default",
+		ResourceName:     "default",
+		RequestType:      "down",
+		DesiredStateName: "default",
+	}
+
+	tests := []struct {
+		name    string
+		url     string
+		headers map[string]string
+		body    string
+		want    *inputs.ScalingRequest
+		wantErr bool
+	}{
+		{
+			name: "grafana: alerting",
+			url:  "/down",
+			body: `{"state":"alerting", "tags":{"resource":"web"}}`,
+			want: &inputs.ScalingRequest{
+				Source:           "default",
+				ResourceName:     "web",
+				RequestType:      "down",
+				DesiredStateName: "default",
+			},
+		},
+		{
+			name: "grafana: alerting without tags",
+			url:  "/down",
+			body: `{"state":"alerting"}`,
+			want: base,
+		},
+		{
+			name: "grafana: ok",
+			url:  "/down",
+			body: `{"state":"ok"}`,
+			want: nil,
+		},
+		{
+			name:    "alertmanager: firing",
+			url:     "/down",
+			headers: map[string]string{"User-Agent": "Alertmanager/0.25.0"},
+			body:    `{"status":"firing", "commonLabels":{"resource":"db", "desired":"small"}, "alerts":[{}, {}]}`,
+			want: &inputs.ScalingRequest{
+				Source:           "default",
+				ResourceName:     "db",
+				RequestType:      "down",
+				DesiredStateName: "small",
+				Step:             2,
+			},
+		},
+		{
+			name:    "alertmanager: missing label",
+			url:     "/down",
+			headers: map[string]string{"User-Agent": "Alertmanager/0.25.0"},
+			body:    `{"status":"firing", "commonLabels":{}, "alerts":[{}]}`,
+			wantErr: true,
+		},
+		{
+			name: "custom: overwrite request type",
+			url:  "/down?vendor=custom",
+			body: `{"value":95}`,
+			want: &inputs.ScalingRequest{
+				Source:           "custom",
+				ResourceName:     "default",
+				RequestType:      "up",
+				DesiredStateName: "default",
+			},
+		},
+		{
+			name: "custom: keep request type",
+			url:  "/down?vendor=custom",
+			body: `{"value":85}`,
+			want: &inputs.ScalingRequest{
+				Source:           "custom",
+				ResourceName:     "default",
+				RequestType:      "down",
+				DesiredStateName: "default",
+			},
+		},
+		{
+			name: "no rule matched",
+			url:  "/down",
+			body: `not a json`,
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := NewInput("", "", "", test.Logger, config)
+			req := httptest.NewRequest(http.MethodPost, tt.url, bytes.NewReader([]byte(tt.body)))
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+
+			got, err := in.BuildScalingRequest(req, base)
+			require.Equal(t, tt.wantErr, err != nil, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_toUint32(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       ref.Val
+		want    uint32
+		wantErr bool
+	}{
+		{name: "int", v: types.Int(2), want: 2},
+		{name: "uint", v: types.Uint(3), want: 3},
+		{name: "integral double", v: types.Double(4), want: 4},
+		{name: "non-integral double", v: types.Double(1.5), wantErr: true},
+		{name: "NaN", v: types.Double(math.NaN()), wantErr: true},
+		{name: "negative", v: types.Int(-1), wantErr: true},
+		{name: "overflow", v: types.Double(math.MaxUint32 + 1), wantErr: true},
+		{name: "string", v: types.String("1"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := toUint32(tt.v)
+			require.Equal(t, tt.wantErr, err != nil, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
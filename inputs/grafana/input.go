@@ -16,14 +16,17 @@ package grafana
 
 import (
 	"encoding/json"
-	"io"
+	"fmt"
 	"log/slog"
 	"net/http"
 
+	"github.com/sacloud/autoscaler/inputs"
 	"github.com/sacloud/autoscaler/version"
 )
 
 type Input struct {
+	inputs.ScalingRequestBuilderBase
+
 	dest       string
 	addr       string
 	configPath string
@@ -62,23 +65,63 @@ func (in *Input) GetLogger() *slog.Logger {
 	return in.logger
 }
 
-func (in *Input) ShouldAccept(req *http.Request) (bool, error) {
-	if req.Method == http.MethodPost || req.Method == http.MethodPut {
-		reqData, err := io.ReadAll(req.Body)
-		if err != nil {
-			return false, err
-		}
-		var received grafanaWebhookBody
-		if err := json.Unmarshal(reqData, &received); err != nil {
-			return false, err
-		}
-		if received.State == "alerting" {
-			return true, nil
-		}
+// BuildScalingRequest inputs.ScalingRequestBuilderの実装
+//
+// アラートのラベル/アノテーション(旧来のアラートの場合はタグ)をLabelsに、アラートのURLをPayloadRefに設定する
+func (in *Input) BuildScalingRequest(req *http.Request, base *inputs.ScalingRequest) (*inputs.ScalingRequest, error) {
+	received, err := in.parseBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if received == nil || received.State != "alerting" {
+		return nil, nil
+	}
+
+	// 同じキーの場合はラベルを優先する
+	scalingReq := base.WithLabels(received.Tags).WithLabels(received.CommonAnnotations).WithLabels(received.CommonLabels)
+	scalingReq.PayloadRef = received.RuleURL
+	if scalingReq.PayloadRef == "" {
+		scalingReq.PayloadRef = received.ExternalURL
+	}
+	return scalingReq, nil
+}
+
+func (in *Input) parseBody(req *http.Request) (*grafanaWebhookBody, error) {
+	var received grafanaWebhookBody
+	if ok, err := inputs.DecodeJSONBody(req, &received, http.MethodPost, http.MethodPut); !ok || err != nil {
+		return nil, err
 	}
-	return false, nil
+	return &received, nil
+}
+
+// Fingerprint inputs.Fingerprinterの実装
+//
+// 同じアラートの再通知を同一とみなすため、groupKey(Grafana Alerting)またはruleId(旧来のアラート)を識別子とする
+func (in *Input) Fingerprint(body []byte) (string, error) {
+	var received grafanaWebhookBody
+	if err := json.Unmarshal(body, &received); err != nil {
+		return "", err
+	}
+	if received.GroupKey != "" {
+		return received.GroupKey, nil
+	}
+	if received.RuleID != 0 {
+		return fmt.Sprintf("rule-%d", received.RuleID), nil
+	}
+	return "", nil
 }
 
 type grafanaWebhookBody struct {
-	State string `json:"state"`
+	State    string `json:"state"`
+	GroupKey string `json:"groupKey"`
+	RuleID   int64  `json:"ruleId"`
+
+	// 旧来のアラートの場合のみ
+	RuleURL string            `json:"ruleUrl"`
+	Tags    map[string]string `json:"tags"`
+
+	// Grafana Alertingの場合のみ
+	ExternalURL       string            `json:"externalURL"`
+	CommonLabels      map[string]string `json:"commonLabels"`
+	CommonAnnotations map[string]string `json:"commonAnnotations"`
 }
@@ -0,0 +1,114 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grafana
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sacloud/autoscaler/inputs"
+	"github.com/sacloud/autoscaler/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInput_BuildScalingRequest(t *testing.T) {
+	base := &inputs.ScalingRequest{
+		Source:           "default",
+		ResourceName:     "default",
+		RequestType:      "up",
+		DesiredStateName: "default",
+	}
+
+	tests := []struct {
+		name    string
+		method  string
+		body    string
+		want    *inputs.ScalingRequest
+		wantErr bool
+	}{
+		{
+			name:   "legacy alerting",
+			method: http.MethodPost,
+			body: `{
+				"state": "alerting",
+				"ruleId": 1,
+				"ruleUrl": "http://localhost:3000/d/hZ7BuVbWz/test-dashboard",
+				"tags": {"tag name": "tag value"}
+			}`,
+			want: &inputs.ScalingRequest{
+				Source:           "default",
+				ResourceName:     "default",
+				RequestType:      "up",
+				DesiredStateName: "default",
+				Labels: map[string]string{
+					"tag name": "tag value",
+				},
+				PayloadRef: "http://localhost:3000/d/hZ7BuVbWz/test-dashboard",
+			},
+		},
+		{
+			name:   "grafana alerting",
+			method: http.MethodPut,
+			body: `{
+				"state": "alerting",
+				"groupKey": "{}:{alertname=\"HighCPU\"}",
+				"externalURL": "http://localhost:3000/",
+				"commonLabels": {"alertname": "HighCPU"},
+				"commonAnnotations": {"summary": "CPU usage is high"}
+			}`,
+			want: &inputs.ScalingRequest{
+				Source:           "default",
+				ResourceName:     "default",
+				RequestType:      "up",
+				DesiredStateName: "default",
+				Labels: map[string]string{
+					"alertname": "HighCPU",
+					"summary":   "CPU usage is high",
+				},
+				PayloadRef: "http://localhost:3000/",
+			},
+		},
+		{
+			name:   "ok",
+			method: http.MethodPost,
+			body:   `{"state": "ok", "tags": {"tag name": "tag value"}}`,
+			want:   nil,
+		},
+		{
+			name:   "not POST or PUT",
+			method: http.MethodGet,
+			body:   `{"state": "alerting"}`,
+			want:   nil,
+		},
+		{
+			name:    "invalid json",
+			method:  http.MethodPost,
+			body:    `{`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := NewInput("", "", "", test.Logger)
+			req := httptest.NewRequest(tt.method, "/up", bytes.NewReader([]byte(tt.body)))
+
+			got, err := in.BuildScalingRequest(req, base)
+			require.Equal(t, tt.wantErr, err != nil, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
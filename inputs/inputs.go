@@ -15,13 +15,21 @@
 package inputs
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"net"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -33,10 +41,14 @@ import (
 	"google.golang.org/grpc"
 )
 
+// defaultShutdownTimeout 終了時に処理中のリクエストの完了とスプールの送信を待つ時間(秒数)
+const defaultShutdownTimeout = 30
+
 var (
 	webhookBodyMaxLen      = int64(64 * 1024) // 64KB
 	allowedQueryStringKeys = []string{
-		"source", "resource-name", "desired-state-name",
+		"source", "resource-name", "desired-state-name", "step",
+		"desired-size", "desired-core", "desired-memory", "desired-cps", "desired-band-width",
 	}
 )
 
@@ -51,6 +63,66 @@ type Input interface {
 	GetLogger() *slog.Logger
 }
 
+// ScalingRequestBuilder Webhookの内容からCoreへのリクエストパラメータを決定するInputが実装するインターフェース
+//
+// InputがScalingRequestBuilderを実装している場合、ShouldAcceptの代わりにBuildScalingRequestが呼ばれる。
+// 引数のbaseにはURLのパスとクエリストリングから組み立てた値が設定されている。
+// クエリストリングのキーは検証されないため、Input独自のキーをreqから参照できる。
+// nil,nilを返した場合はリクエストを無視する
+type ScalingRequestBuilder interface {
+	BuildScalingRequest(req *http.Request, base *ScalingRequest) (*ScalingRequest, error)
+}
+
+// ScalingRequestBuilderBase ScalingRequestBuilderを実装するInputに埋め込みShouldAcceptを実装する
+//
+// ScalingRequestBuilderを実装したInputのShouldAcceptはserverから呼ばれず、
+// 受け付けるかどうかはBuildScalingRequestで判定するため常にtrueを返す
+type ScalingRequestBuilderBase struct{}
+
+// ShouldAccept Input.ShouldAcceptの実装
+func (ScalingRequestBuilderBase) ShouldAccept(*http.Request) (bool, error) {
+	return true, nil
+}
+
+// DecodeJSONBody リクエストのHTTPメソッドがmethodsのいずれかである場合にボディをJSONとしてvへデコードする
+//
+// HTTPメソッドが一致しない場合はボディを読まずにfalseを返す
+func DecodeJSONBody(req *http.Request, v interface{}, methods ...string) (bool, error) {
+	if !slices.Contains(methods, req.Method) {
+		return false, nil
+	}
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// DeliveryObserver Webhookから組み立てたリクエストの送信結果を受け取るInputが実装するインターフェース
+//
+// Coreへの送信(スプールへの保存を含む)に成功した場合はerrにnilが渡される。
+// 送信に失敗した場合や重複排除/流量制限により破棄された場合はエラーが渡される
+type DeliveryObserver interface {
+	ObserveDelivery(scalingReq *ScalingRequest, err error)
+}
+
+// Poller 外部から取得した値を元に定期的にCoreへのリクエストを行うInputが実装するインターフェース
+//
+// InputがPollerを実装している場合、Webhookサーバに加えてPollがバックグラウンドで実行される。
+// Pollはctxがキャンセルされるまでブロックする。
+// stdinの終端に達した場合などnilを返して終了した場合は、処理中のリクエストとスプールを送信してからサービス全体を終了する
+type Poller interface {
+	Poll(ctx context.Context, sender Sender) error
+}
+
+// Sender Coreへのリクエストを送信する
+type Sender interface {
+	Send(ctx context.Context, scalingReq *ScalingRequest) (*request.ScalingResponse, error)
+}
+
 func FullName(input Input) string {
 	return fmt.Sprintf("autoscaler-inputs-%s", input.Name())
 }
@@ -58,40 +130,82 @@ func FullName(input Input) string {
 func Serve(ctx context.Context, input Input) error {
 	initMetrics()
 
-	errCh := make(chan error)
+	errCh := make(chan error, 4)
+	pollerDone := make(chan struct{})
 
 	conf, err := LoadConfigFromPath(input.ConfigPath())
 	if err != nil {
 		return err
 	}
 
+	server, err := newServer(input, conf)
+	if err != nil {
+		return err
+	}
+
+	// 各コンポーネントはServe終了時に停止させる
+	// Webhookのリクエストのコンテキストもここから派生させ、ctxがキャンセルされたら処理中の再送を中断する
+	serveCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	server.BaseContext = func(net.Listener) context.Context { return serveCtx }
+
 	// webhook
-	go func() {
-		errCh <- startWebhookServer(ctx, input, conf)
-	}()
+	// ListenAddressが空の場合はWebhookを受け付けずPollerのみで動作する
+	if input.ListenAddress() != "" {
+		go func() {
+			if err := server.listenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+				errCh <- err
+			}
+		}()
+	}
+
+	// poller
+	// stdinの終端に達した場合などPollerが正常に終了した場合はサービス全体を終了する
+	if poller, ok := input.(Poller); ok {
+		go func() {
+			if err := poller.Poll(serveCtx, server); err != nil {
+				if serveCtx.Err() == nil {
+					errCh <- err
+				}
+				return
+			}
+			close(pollerDone)
+		}()
+	}
+
+	// spool
+	if server.delivery.spool != nil {
+		go func() {
+			if err := server.delivery.spool.run(serveCtx, server.send); serveCtx.Err() == nil {
+				errCh <- err
+			}
+		}()
+	}
 
 	// exporter
 	if conf != nil && conf.ExporterConfig != nil && conf.ExporterConfig.Enabled {
 		go func() {
-			errCh <- startExporter(ctx, input, conf.ExporterConfig)
+			if err := startExporter(serveCtx, input, conf.ExporterConfig); err != nil {
+				errCh <- err
+			}
 		}()
 	}
 
+	var result error
 	select {
 	case err := <-errCh:
-		return fmt.Errorf("inputs service failed: %s", err)
+		result = fmt.Errorf("inputs service failed: %s", err)
+	case <-pollerDone:
+		input.GetLogger().Info("poller finished")
 	case <-ctx.Done():
 		input.GetLogger().Info("shutting down", slog.Any("error", ctx.Err()))
+		result = ctx.Err()
 	}
-	return ctx.Err()
-}
 
-func startWebhookServer(_ context.Context, input Input, conf *Config) error {
-	server, err := newServer(input, conf)
-	if err != nil {
-		return err
-	}
-	return server.listenAndServe()
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.WithoutCancel(ctx), defaultShutdownTimeout*time.Second)
+	defer shutdownCancel()
+	server.shutdown(shutdownCtx)
+	return result
 }
 
 func startExporter(_ context.Context, input Input, conf *config.ExporterConfig) error {
@@ -117,6 +231,8 @@ type server struct {
 	input         Input
 	logger        *slog.Logger
 	config        *Config
+	delivery      *delivery
+	limiter       *limiter
 
 	*http.Server
 }
@@ -124,6 +240,19 @@ type server struct {
 func newServer(input Input, conf *Config) (*server, error) {
 	serveMux := http.NewServeMux()
 
+	var deliveryConf *DeliveryConfig
+	var dedupConf *DedupConfig
+	var rateLimitConf *RateLimitConfig
+	if conf != nil {
+		deliveryConf = conf.Delivery
+		dedupConf = conf.Dedup
+		rateLimitConf = conf.RateLimit
+	}
+	d, err := newDelivery(deliveryConf, input.GetLogger())
+	if err != nil {
+		return nil, err
+	}
+
 	s := &server{
 		coreAddress:   input.Destination(),
 		listenAddress: input.ListenAddress(),
@@ -131,6 +260,8 @@ func newServer(input Input, conf *Config) (*server, error) {
 		input:         input,
 		logger:        input.GetLogger(),
 		config:        conf,
+		delivery:      d,
+		limiter:       newLimiter(dedupConf, rateLimitConf),
 		Server:        &http.Server{Addr: input.ListenAddress(), Handler: serveMux}, //nolint:gosec
 	}
 
@@ -156,6 +287,17 @@ func newServer(input Input, conf *Config) (*server, error) {
 	serveMux.HandleFunc("/up", upWebhookHandler)
 	serveMux.HandleFunc("/down", downWebhookHandler)
 
+	if conf != nil && conf.Wake != nil {
+		wakeHandler := promhttp.InstrumentHandlerCounter(
+			counter,
+			promhttp.InstrumentHandlerCounter(
+				wakeCounter,
+				http.HandlerFunc(s.handleWake),
+			),
+		)
+		serveMux.HandleFunc("/wake", wakeHandler)
+	}
+
 	serveMux.HandleFunc("/healthz", func(w http.ResponseWriter, req *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte("ok")) //nolint:errcheck
@@ -179,6 +321,20 @@ func (s *server) serve(l net.Listener) error {
 	return s.Serve(l)
 }
 
+// shutdown Webhookの処理中のリクエストの完了を待ってから、スプールに残っているリクエストの送信を試みる
+//
+// 送信できなかったリクエストはスプールに残り、次回起動時に送信される
+func (s *server) shutdown(ctx context.Context) {
+	if err := s.Shutdown(ctx); err != nil {
+		s.logger.Error("shutting down webhook server failed", slog.Any("error", err))
+	}
+	if s.delivery.spool != nil {
+		if err := s.delivery.spool.flush(ctx, s.send); err != nil {
+			s.logger.Error("flushing spool failed", slog.Any("error", err))
+		}
+	}
+}
+
 func (s *server) handle(requestType string, w http.ResponseWriter, req *http.Request) {
 	// bodyをwebhookBodyMaxLenまでに制限
 	req.Body = http.MaxBytesReader(w, req.Body, webhookBodyMaxLen)
@@ -195,18 +351,53 @@ func (s *server) handle(requestType string, w http.ResponseWriter, req *http.Req
 		w.Write([]byte(`{"message":"ignored"}`)) //nolint:errcheck
 		return
 	}
+	s.handleScalingRequest(req.Context(), w, scalingReq)
+}
+
+// handleScalingRequest 重複排除/流量制限を行った上でCoreへリクエストを送信し、結果をレスポンスとして返す
+//
+// ctxにはWebhookのリクエストのコンテキストを渡す、クライアントの切断やサーバの停止時には再送を中断する
+func (s *server) handleScalingRequest(ctx context.Context, w http.ResponseWriter, scalingReq *ScalingRequest) {
+	if allowed, reason := s.limiter.allow(scalingReq); !allowed {
+		s.logger.Info(
+			"webhook dropped",
+			slog.String("reason", reason),
+			slog.String("request-type", scalingReq.RequestType),
+			slog.String("resource-name", scalingReq.ResourceName),
+		)
+		droppedCounter.WithLabelValues(reason, scalingReq.RequestType).Inc()
+		s.observeDelivery(scalingReq, &DroppedError{Reason: reason})
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(fmt.Sprintf(`{"message":"dropped", "reason":"%s"}`, reason))) //nolint:errcheck
+		return
+	}
+
+	dests := s.destinations(scalingReq)
+	if len(dests) > 1 {
+		s.handleFanOut(ctx, w, scalingReq, dests)
+		return
+	}
 
 	s.logger.Info(
 		"sending request to the Core server",
 		slog.String("request-type", scalingReq.RequestType),
 	)
 
-	res, err := s.send(scalingReq)
+	res, spooled, err := s.delivery.deliver(ctx, dests[0], scalingReq, s.send)
+	s.observeDelivery(scalingReq, err)
 	if err != nil {
+		s.limiter.forget(scalingReq)
 		s.logger.Error(err.Error())
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
+	if spooled {
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"message":"spooled"}`)) //nolint:errcheck
+		return
+	}
 
 	s.logger.Info(
 		"webhook handled",
@@ -220,6 +411,102 @@ func (s *server) handle(requestType string, w http.ResponseWriter, req *http.Req
 	w.Write([]byte(fmt.Sprintf(`{"id":"%s", "status":"%s", "message":"%s"}`, res.ScalingJobId, res.Status, res.Message))) //nolint:errcheck
 }
 
+// deliveryResult 複数のCoreへ送信した場合の送信先ごとの結果
+type deliveryResult struct {
+	Destination string `json:"destination"`
+	ID          string `json:"id,omitempty"`
+	Status      string `json:"status,omitempty"`
+	Message     string `json:"message,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// handleFanOut 複数のCoreへ並列にリクエストを送信し、結果をまとめてレスポンスとして返す
+//
+// 全ての送信先への送信に失敗した場合のみ500を返す
+func (s *server) handleFanOut(ctx context.Context, w http.ResponseWriter, scalingReq *ScalingRequest, dests []string) {
+	s.logger.Info(
+		"sending request to the Core servers",
+		slog.String("request-type", scalingReq.RequestType),
+		slog.Any("destinations", dests),
+	)
+
+	results := make([]*deliveryResult, len(dests))
+	var wg sync.WaitGroup
+	for i, dest := range dests {
+		wg.Add(1)
+		go func(i int, dest string) {
+			defer wg.Done()
+			result := &deliveryResult{Destination: s.destinationName(dest)}
+			res, spooled, err := s.delivery.deliver(ctx, dest, scalingReq, s.send)
+			switch {
+			case err != nil:
+				s.logger.Error(err.Error(), slog.String("destination", result.Destination))
+				result.Error = err.Error()
+			case spooled:
+				result.Message = "spooled"
+			default:
+				result.ID = res.ScalingJobId
+				result.Status = res.Status.String()
+				result.Message = res.Message
+			}
+			results[i] = result
+		}(i, dest)
+	}
+	wg.Wait()
+
+	statusCode := http.StatusInternalServerError
+	for _, r := range results {
+		if r.Error == "" {
+			statusCode = http.StatusOK
+			break
+		}
+	}
+	if statusCode != http.StatusOK {
+		s.limiter.forget(scalingReq)
+		s.observeDelivery(scalingReq, fmt.Errorf("sending request to all destinations failed"))
+	} else {
+		s.observeDelivery(scalingReq, nil)
+	}
+
+	data, err := json.Marshal(map[string]interface{}{"results": results})
+	if err != nil {
+		s.logger.Error(err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	s.logger.Info("webhook handled", slog.String("results", string(data)))
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	w.Write(data) //nolint:errcheck
+}
+
+// observeDelivery InputがDeliveryObserverを実装している場合に送信結果を通知する
+func (s *server) observeDelivery(scalingReq *ScalingRequest, err error) {
+	if observer, ok := s.input.(DeliveryObserver); ok {
+		observer.ObserveDelivery(scalingReq, err)
+	}
+}
+
+// destinations scalingReqの送信先を返す
+func (s *server) destinations(scalingReq *ScalingRequest) []string {
+	if s.config == nil {
+		return []string{""}
+	}
+	return findDestinations(s.config.Routes, scalingReq)
+}
+
+// destinationName ログやレスポンスに出力するための送信先の名前を返す
+func (s *server) destinationName(dest string) string {
+	if dest != "" {
+		return dest
+	}
+	if s.coreAddress != "" {
+		return s.coreAddress
+	}
+	return "default"
+}
+
 func (s *server) parseRequest(requestType string, req *http.Request) (*ScalingRequest, error) {
 	s.logger.Info("webhook received")
 
@@ -229,20 +516,76 @@ func (s *server) parseRequest(requestType string, req *http.Request) (*ScalingRe
 	}
 	s.logger.Debug("", slog.String("request", string(dump)))
 
-	shouldAccept, err := s.input.ShouldAccept(req)
+	var fingerprint string
+	if s.limiter.needFingerprint() {
+		fingerprint, err = s.fingerprint(req)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	builder, isBuilder := s.input.(ScalingRequestBuilder)
+	if !isBuilder {
+		shouldAccept, err := s.input.ShouldAccept(req)
+		if err != nil {
+			return nil, err
+		}
+		if !shouldAccept {
+			s.logger.Info("webhook ignored")
+			return nil, nil
+		}
+		// ScalingRequestBuilderは任意のクエリストリングを参照できるためキーの検証は行わない
+		if err := s.validateQueryString(req.URL.Query()); err != nil {
+			return nil, err
+		}
+	}
+
+	scalingReq, err := s.scalingRequestFromQueryString(requestType, req.URL.Query())
 	if err != nil {
 		return nil, err
 	}
-	if !shouldAccept {
-		s.logger.Info("webhook ignored")
-		return nil, nil
+
+	if isBuilder {
+		scalingReq, err = builder.BuildScalingRequest(req, scalingReq)
+		if err != nil {
+			return nil, err
+		}
+		if scalingReq == nil {
+			s.logger.Info("webhook ignored")
+			return nil, nil
+		}
 	}
 
-	queryStrings := req.URL.Query()
-	if err := s.validateQueryString(queryStrings); err != nil {
+	if err := scalingReq.Validate(); err != nil {
 		return nil, err
 	}
+	scalingReq.fingerprint = fingerprint
+	return scalingReq, nil
+}
+
+// fingerprint 重複排除に用いる識別子を返す
+//
+// InputがFingerprinterを実装していればその値を、そうでなければリクエストボディのハッシュ値を返す
+func (s *server) fingerprint(req *http.Request) (string, error) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return "", err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	if fp, ok := s.input.(Fingerprinter); ok {
+		v, err := fp.Fingerprint(body)
+		if err != nil {
+			return "", err
+		}
+		if v != "" {
+			return v, nil
+		}
+	}
+	return bodyFingerprint(body), nil
+}
 
+func (s *server) scalingRequestFromQueryString(requestType string, queryStrings url.Values) (*ScalingRequest, error) {
 	source := queryStrings.Get("source")
 	if source == "" {
 		source = defaults.SourceName
@@ -255,17 +598,66 @@ func (s *server) parseRequest(requestType string, req *http.Request) (*ScalingRe
 	if desiredStateName == "" {
 		desiredStateName = defaults.DesiredStateName
 	}
+	step, _, err := parseUint32Query(queryStrings, "step")
+	if err != nil {
+		return nil, err
+	}
+	desiredSpec, err := desiredSpecFromQueryString(queryStrings)
+	if err != nil {
+		return nil, err
+	}
 
-	scalingReq := &ScalingRequest{
+	return &ScalingRequest{
 		Source:           source,
 		ResourceName:     resourceName,
 		RequestType:      requestType,
 		DesiredStateName: desiredStateName,
+		Step:             step,
+		DesiredSpec:      desiredSpec,
+	}, nil
+}
+
+// desiredSpecFromQueryString クエリストリングからDesiredSpecを組み立てる、いずれの項目も指定されていない場合はnilを返す
+func desiredSpecFromQueryString(queryStrings url.Values) (*DesiredSpec, error) {
+	spec := &DesiredSpec{}
+	fields := []struct {
+		key  string
+		dest *uint32
+	}{
+		{key: "desired-size", dest: &spec.Size},
+		{key: "desired-core", dest: &spec.Core},
+		{key: "desired-memory", dest: &spec.Memory},
+		{key: "desired-cps", dest: &spec.CPS},
+		{key: "desired-band-width", dest: &spec.BandWidth},
 	}
-	if err := scalingReq.Validate(); err != nil {
-		return nil, err
+
+	specified := false
+	for _, f := range fields {
+		v, found, err := parseUint32Query(queryStrings, f.key)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			*f.dest = v
+			specified = true
+		}
 	}
-	return scalingReq, nil
+	if !specified {
+		return nil, nil
+	}
+	return spec, nil
+}
+
+func parseUint32Query(queryStrings url.Values, key string) (uint32, bool, error) {
+	v := queryStrings.Get(key)
+	if v == "" {
+		return 0, false, nil
+	}
+	parsed, err := strconv.ParseUint(v, 10, 32)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid %s: %s", key, v)
+	}
+	return uint32(parsed), true, nil
 }
 
 func (s *server) validateQueryString(query url.Values) error {
@@ -288,20 +680,55 @@ func (s *server) validateQueryString(query url.Values) error {
 	return nil
 }
 
-func (s *server) send(scalingReq *ScalingRequest) (*request.ScalingResponse, error) {
+// Send Sender.Sendの実装
+//
+// 重複排除/レート制限により破棄した場合は*DroppedErrorを返す。
+// Coreへ接続できなかった場合は設定に応じて再送する。
+// Pollerは定期的に最新の値で再評価するため、スプールへの保存は行わない。
+// 送信先が複数ある場合は全ての送信先へ送信し、最初に成功したレスポンスを返す
+func (s *server) Send(ctx context.Context, scalingReq *ScalingRequest) (*request.ScalingResponse, error) {
+	if err := scalingReq.Validate(); err != nil {
+		return nil, err
+	}
+	if allowed, reason := s.limiter.allow(scalingReq); !allowed {
+		droppedCounter.WithLabelValues(reason, scalingReq.RequestType).Inc()
+		return nil, &DroppedError{Reason: reason}
+	}
+
+	var res *request.ScalingResponse
+	errors := &multierror.Error{}
+	for _, dest := range s.destinations(scalingReq) {
+		r, err := s.delivery.sendWithRetry(ctx, dest, scalingReq, s.send)
+		if err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("%s: %s", s.destinationName(dest), err))
+			continue
+		}
+		if res == nil {
+			res = r
+		}
+	}
+	if res == nil {
+		s.limiter.forget(scalingReq)
+	}
+	return res, errors.ErrorOrNil()
+}
+
+func (s *server) send(ctx context.Context, dest string, scalingReq *ScalingRequest) (*request.ScalingResponse, error) {
 	if scalingReq == nil {
 		return nil, nil
 	}
-	ctx := context.Background()
+	if dest == "" {
+		dest = s.coreAddress
+	}
 
 	dialOption := &grpcutil.DialOption{
-		Destination: s.coreAddress,
+		Destination: dest,
 		DialOpts:    grpcutil.ClientErrorCountInterceptor("inputs_to_core"),
 	}
 
 	conn, cleanup, err := grpcutil.DialContext(ctx, dialOption)
 	if err != nil {
-		return nil, err
+		return nil, &unavailableError{err: err}
 	}
 	defer cleanup()
 
@@ -313,6 +740,8 @@ func (s *server) send(scalingReq *ScalingRequest) (*request.ScalingResponse, err
 		f = req.Up
 	case "down":
 		f = req.Down
+	case "keep":
+		f = req.Keep
 	default:
 		return nil, fmt.Errorf("invalid request type: %s", scalingReq.RequestType)
 	}
@@ -320,5 +749,9 @@ func (s *server) send(scalingReq *ScalingRequest) (*request.ScalingResponse, err
 		Source:           scalingReq.Source,
 		ResourceName:     scalingReq.ResourceName,
 		DesiredStateName: scalingReq.DesiredStateName,
+		Step:             scalingReq.Step,
+		Labels:           scalingReq.Labels,
+		PayloadRef:       scalingReq.PayloadRef,
+		DesiredSpec:      scalingReq.DesiredSpec.ToRequest(),
 	})
 }
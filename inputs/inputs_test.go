@@ -16,15 +16,22 @@ package inputs
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"net"
 	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/prometheus/common/expfmt"
+	"github.com/sacloud/autoscaler/grpcutil"
 	"github.com/sacloud/autoscaler/metrics"
+	"github.com/sacloud/autoscaler/request"
 	"github.com/sacloud/autoscaler/test"
 	"github.com/stretchr/testify/require"
 )
@@ -246,3 +253,479 @@ func Test_server_exporter(t *testing.T) {
 	<-closed1
 	<-closed2
 }
+
+type fakeBuilderInput struct {
+	fakeInput
+	resourceName string
+}
+
+func (i *fakeBuilderInput) BuildScalingRequest(req *http.Request, base *ScalingRequest) (*ScalingRequest, error) {
+	if i.resourceName == "" {
+		return nil, nil
+	}
+	r := *base
+	r.ResourceName = i.resourceName
+	return &r, nil
+}
+
+func Test_server_parseRequest_withBuilder(t *testing.T) {
+	tests := []struct {
+		name         string
+		resourceName string
+		url          string
+		want         *ScalingRequest
+		wantErr      bool
+	}{
+		{
+			name:         "overwrite",
+			resourceName: "from-body",
+			url:          "/up?source=foo&resource-name=from-query",
+			want: &ScalingRequest{
+				Source:           "foo",
+				ResourceName:     "from-body",
+				RequestType:      "up",
+				DesiredStateName: "default",
+			},
+		},
+		{
+			name:         "ignored",
+			resourceName: "",
+			url:          "/up",
+			want:         nil,
+		},
+		{
+			name:         "unknown query string",
+			resourceName: "from-body",
+			url:          "/up?vendor=custom",
+			want: &ScalingRequest{
+				Source:           "default",
+				ResourceName:     "from-body",
+				RequestType:      "up",
+				DesiredStateName: "default",
+			},
+		},
+		{
+			name:         "desired spec",
+			resourceName: "from-body",
+			url:          "/up?desired-core=4&desired-memory=8",
+			want: &ScalingRequest{
+				Source:           "default",
+				ResourceName:     "from-body",
+				RequestType:      "up",
+				DesiredStateName: "default",
+				DesiredSpec:      &DesiredSpec{Core: 4, Memory: 8},
+			},
+		},
+		{
+			name:         "desired spec with zero size",
+			resourceName: "from-body",
+			url:          "/up?desired-size=0",
+			want: &ScalingRequest{
+				Source:           "default",
+				ResourceName:     "from-body",
+				RequestType:      "up",
+				DesiredStateName: "default",
+				DesiredSpec:      &DesiredSpec{Size: 0},
+			},
+		},
+		{
+			name:         "invalid desired spec",
+			resourceName: "from-body",
+			url:          "/up?desired-size=-1",
+			wantErr:      true,
+		},
+		{
+			name:         "desired spec with desired state name",
+			resourceName: "from-body",
+			url:          "/up?desired-size=3&desired-state-name=large",
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &fakeBuilderInput{resourceName: tt.resourceName}
+			server, err := newServer(input, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req, err := http.NewRequest(http.MethodPost, tt.url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := server.parseRequest("up", req)
+			require.Equal(t, tt.wantErr, err != nil, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_server_handle_spooled(t *testing.T) {
+	// Destinationが空かつデフォルトのソケットファイルも存在しないためCoreへは接続できない
+	input := &fakeBuilderInput{resourceName: "example"}
+	dir := t.TempDir()
+	server, err := newServer(input, &Config{
+		Delivery: &DeliveryConfig{
+			Spool: &SpoolConfig{Dir: dir},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/up", nil)
+	rec := httptest.NewRecorder()
+	server.handle("up", rec, req)
+
+	require.Equal(t, http.StatusAccepted, rec.Code)
+	require.JSONEq(t, `{"message":"spooled"}`, rec.Body.String())
+
+	names, err := server.delivery.spool.entries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Len(t, names, 1)
+}
+
+func Test_server_handle_canceled(t *testing.T) {
+	// Coreへ接続できない状態で、クライアントが切断した場合は再送を中断しスプールにも保存しない
+	input := &fakeBuilderInput{resourceName: "example"}
+	server, err := newServer(input, &Config{
+		Delivery: &DeliveryConfig{
+			MaxRetries:      3,
+			InitialInterval: 60,
+			Spool:           &SpoolConfig{Dir: t.TempDir()},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req := httptest.NewRequest(http.MethodPost, "/up", nil).WithContext(ctx)
+	rec := httptest.NewRecorder()
+	server.handle("up", rec, req)
+
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	names, err := server.delivery.spool.entries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Empty(t, names)
+}
+
+type fakeQueryBuilderInput struct {
+	fakeInput
+}
+
+func (i *fakeQueryBuilderInput) BuildScalingRequest(req *http.Request, base *ScalingRequest) (*ScalingRequest, error) {
+	if req.URL.Query().Get("vendor") != "custom" {
+		return nil, nil
+	}
+	return base, nil
+}
+
+type fakeAcceptInput struct {
+	fakeInput
+}
+
+func (i *fakeAcceptInput) ShouldAccept(req *http.Request) (bool, error) {
+	return true, nil
+}
+
+func Test_server_handle_queryString(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Input
+		url      string
+		wantCode int
+		wantBody string
+	}{
+		{
+			name:     "builder can refer unknown key",
+			input:    &fakeQueryBuilderInput{},
+			url:      "/up?vendor=custom",
+			wantCode: http.StatusAccepted,
+			wantBody: `{"message":"spooled"}`,
+		},
+		{
+			name:     "builder ignored",
+			input:    &fakeQueryBuilderInput{},
+			url:      "/up?vendor=other",
+			wantCode: http.StatusOK,
+			wantBody: `{"message":"ignored"}`,
+		},
+		{
+			name:     "unknown key without builder",
+			input:    &fakeAcceptInput{},
+			url:      "/up?vendor=custom",
+			wantCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, err := newServer(tt.input, &Config{
+				Delivery: &DeliveryConfig{
+					Spool: &SpoolConfig{Dir: t.TempDir()},
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, tt.url, nil)
+			rec := httptest.NewRecorder()
+			server.Handler.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantCode, rec.Code)
+			if tt.wantBody != "" {
+				require.JSONEq(t, tt.wantBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func Test_server_handle_dropped(t *testing.T) {
+	input := &fakeBuilderInput{resourceName: "example"}
+	server, err := newServer(input, &Config{
+		Dedup: &DedupConfig{Window: 60, Keys: []string{"fingerprint", "request_type"}},
+		Delivery: &DeliveryConfig{
+			Spool: &SpoolConfig{Dir: t.TempDir()},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		body     string
+		wantBody string
+	}{
+		{body: `{"id":1}`, wantBody: `{"message":"spooled"}`},
+		{body: `{"id":1}`, wantBody: `{"message":"dropped", "reason":"duplicated"}`},
+		{body: `{"id":2}`, wantBody: `{"message":"spooled"}`},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPost, "/up", strings.NewReader(tt.body))
+		rec := httptest.NewRecorder()
+		server.handle("up", rec, req)
+
+		require.JSONEq(t, tt.wantBody, rec.Body.String())
+	}
+}
+
+type fakeObserverInput struct {
+	fakeBuilderInput
+	observed []error
+}
+
+func (i *fakeObserverInput) ObserveDelivery(_ *ScalingRequest, err error) {
+	i.observed = append(i.observed, err)
+}
+
+func Test_server_handle_observeDelivery(t *testing.T) {
+	tests := []struct {
+		name     string
+		conf     *Config
+		requests int
+		want     []string // 通知されたエラーの種別
+	}{
+		{
+			name: "spooled",
+			conf: &Config{
+				Delivery: &DeliveryConfig{Spool: &SpoolConfig{Dir: t.TempDir()}},
+			},
+			requests: 1,
+			want:     []string{""},
+		},
+		{
+			name:     "core is unreachable",
+			conf:     &Config{},
+			requests: 1,
+			want:     []string{"error"},
+		},
+		{
+			name: "dropped",
+			conf: &Config{
+				Dedup:    &DedupConfig{Window: 60, Keys: []string{"request_type"}},
+				Delivery: &DeliveryConfig{Spool: &SpoolConfig{Dir: t.TempDir()}},
+			},
+			requests: 2,
+			want:     []string{"", "dropped"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &fakeObserverInput{fakeBuilderInput: fakeBuilderInput{resourceName: "example"}}
+			server, err := newServer(input, tt.conf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i := 0; i < tt.requests; i++ {
+				req := httptest.NewRequest(http.MethodPost, "/up", nil)
+				rec := httptest.NewRecorder()
+				server.handle("up", rec, req)
+			}
+
+			var got []string
+			for _, err := range input.observed {
+				var dropped *DroppedError
+				switch {
+				case err == nil:
+					got = append(got, "")
+				case errors.As(err, &dropped):
+					got = append(got, "dropped")
+				default:
+					got = append(got, "error")
+				}
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+type fakeScalingService struct {
+	request.UnimplementedScalingServiceServer
+}
+
+func (s *fakeScalingService) Up(context.Context, *request.ScalingRequest) (*request.ScalingResponse, error) {
+	return &request.ScalingResponse{
+		ScalingJobId: "default",
+		Status:       request.ScalingJobStatus_JOB_ACCEPTED,
+	}, nil
+}
+
+func Test_server_handle_fanOut(t *testing.T) {
+	grpcServer, listener, cleanup, err := grpcutil.Server(&grpcutil.ListenerOption{Address: "localhost:0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	request.RegisterScalingServiceServer(grpcServer, &fakeScalingService{})
+	go grpcServer.Serve(listener) //nolint:errcheck
+	defer func() {
+		grpcServer.GracefulStop()
+		cleanup()
+	}()
+
+	reachable := listener.Addr().String()
+	unreachable := "unix:" + filepath.Join(t.TempDir(), "not-exists.sock")
+
+	tests := []struct {
+		name         string
+		destinations []string
+		wantCode     int
+		wantErrors   []bool
+	}{
+		{
+			name:         "partially failed",
+			destinations: []string{reachable, unreachable},
+			wantCode:     http.StatusOK,
+			wantErrors:   []bool{false, true},
+		},
+		{
+			name:         "all succeeded",
+			destinations: []string{reachable, reachable},
+			wantCode:     http.StatusOK,
+			wantErrors:   []bool{false, false},
+		},
+		{
+			name:         "all failed",
+			destinations: []string{unreachable, unreachable},
+			wantCode:     http.StatusInternalServerError,
+			wantErrors:   []bool{true, true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &fakeBuilderInput{resourceName: "example"}
+			server, err := newServer(input, &Config{
+				Routes: []*RouteConfig{{ResourceName: "example", Destinations: tt.destinations}},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/up", nil)
+			rec := httptest.NewRecorder()
+			server.handle("up", rec, req)
+
+			require.Equal(t, tt.wantCode, rec.Code)
+
+			var got struct {
+				Results []*deliveryResult `json:"results"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+				t.Fatal(err)
+			}
+			require.Len(t, got.Results, len(tt.destinations))
+			for i, r := range got.Results {
+				require.Equal(t, tt.destinations[i], r.Destination)
+				require.Equal(t, tt.wantErrors[i], r.Error != "", r.Error)
+				if !tt.wantErrors[i] {
+					require.Equal(t, "default", r.ID)
+					require.Equal(t, "JOB_ACCEPTED", r.Status)
+				}
+			}
+		})
+	}
+}
+
+func Test_server_Send_dropped(t *testing.T) {
+	grpcServer, listener, cleanup, err := grpcutil.Server(&grpcutil.ListenerOption{Address: "localhost:0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	request.RegisterScalingServiceServer(grpcServer, &fakeScalingService{})
+	go grpcServer.Serve(listener) //nolint:errcheck
+	defer func() {
+		grpcServer.GracefulStop()
+		cleanup()
+	}()
+
+	server, err := newServer(&fakeInput{}, &Config{
+		Dedup:  &DedupConfig{Window: 60},
+		Routes: []*RouteConfig{{Destinations: []string{listener.Addr().String()}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	scalingReq := &ScalingRequest{Source: "default", ResourceName: "example", RequestType: "up", DesiredStateName: "default"}
+
+	res, err := server.Send(context.Background(), scalingReq)
+	require.NoError(t, err)
+	require.Equal(t, "default", res.ScalingJobId)
+
+	_, err = server.Send(context.Background(), scalingReq)
+	var dropped *DroppedError
+	require.ErrorAs(t, err, &dropped)
+	require.Equal(t, dropReasonDuplicated, dropped.Reason)
+}
+
+func TestDecodeJSONBody(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		body    string
+		want    bool
+		wantErr bool
+	}{
+		{name: "decoded", method: http.MethodPost, body: `{"status":"firing"}`, want: true},
+		{name: "not allowed method", method: http.MethodGet, body: `{"status":"firing"}`, want: false},
+		{name: "invalid json", method: http.MethodPut, body: `{`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/up", strings.NewReader(tt.body))
+			var v struct {
+				Status string `json:"status"`
+			}
+			got, err := DecodeJSONBody(req, &v, http.MethodPost, http.MethodPut)
+			require.Equal(t, tt.wantErr, err != nil, err)
+			require.Equal(t, tt.want, got)
+			if got {
+				require.Equal(t, "firing", v.Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inputs
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	dedupKeyFingerprint      = "fingerprint"
+	dedupKeySource           = "source"
+	dedupKeyResourceName     = "resource_name"
+	dedupKeyRequestType      = "request_type"
+	dedupKeyDesiredStateName = "desired_state_name"
+
+	dropReasonDuplicated  = "duplicated"
+	dropReasonRateLimited = "rate_limited"
+)
+
+var defaultDedupKeys = []string{dedupKeyResourceName, dedupKeyRequestType}
+
+// DedupConfig 同一内容のリクエストの重複排除設定
+//
+// 監視ツールからの再通知などにより同じアラートが繰り返し送られてきた場合に、
+// Window秒以内の同一リクエストをCoreへ送信せずに破棄する
+type DedupConfig struct {
+	// Window 同一とみなしたリクエストを破棄する期間(秒数)
+	Window int `yaml:"window" validate:"required,min=1"`
+	// Keys 同一かを判定する際に用いる項目、省略時はresource_nameとrequest_type
+	//
+	// fingerprintを指定した場合、Inputが返すアラートの識別子(Fingerprinterを実装していない場合はリクエストボディのハッシュ値)を用いる
+	Keys []string `yaml:"keys" validate:"omitempty,dive,oneof=fingerprint source resource_name request_type desired_state_name"`
+}
+
+// RateLimitConfig リソースごとのリクエスト数の制限設定
+//
+// リソース名ごとにトークンバケットを持ち、トークンが枯渇している間のリクエストを破棄する
+type RateLimitConfig struct {
+	// Rate 1秒あたりに補充されるトークン数
+	Rate float64 `yaml:"rate" validate:"required,gt=0"`
+	// Burst バケットの容量
+	Burst int `yaml:"burst" validate:"required,min=1"`
+}
+
+// DroppedError 重複排除/レート制限によりリクエストを破棄したことを示すエラー
+type DroppedError struct {
+	Reason string
+}
+
+func (e *DroppedError) Error() string {
+	return fmt.Sprintf("request dropped: %s", e.Reason)
+}
+
+// Fingerprinter Webhookの内容から重複排除に用いる識別子を返すInputが実装するインターフェース
+type Fingerprinter interface {
+	Fingerprint(body []byte) (string, error)
+}
+
+// limiter 重複排除とレート制限を行う
+type limiter struct {
+	dedupWindow time.Duration
+	dedupKeys   []string
+	rate        float64
+	burst       float64
+
+	mu      sync.Mutex
+	seen    map[string]time.Time // key: 重複排除用のキー, value: 期限
+	buckets map[string]*tokenBucket
+	now     func() time.Time
+}
+
+func newLimiter(dedup *DedupConfig, rateLimit *RateLimitConfig) *limiter {
+	if dedup == nil && rateLimit == nil {
+		return nil
+	}
+	l := &limiter{
+		seen:    make(map[string]time.Time),
+		buckets: make(map[string]*tokenBucket),
+		now:     time.Now,
+	}
+	if dedup != nil {
+		l.dedupWindow = time.Duration(dedup.Window) * time.Second
+		l.dedupKeys = dedup.Keys
+		if len(l.dedupKeys) == 0 {
+			l.dedupKeys = defaultDedupKeys
+		}
+	}
+	if rateLimit != nil {
+		l.rate = rateLimit.Rate
+		l.burst = float64(rateLimit.Burst)
+	}
+	return l
+}
+
+// needFingerprint 重複排除のキーにfingerprintが含まれるか
+func (l *limiter) needFingerprint() bool {
+	if l == nil {
+		return false
+	}
+	for _, k := range l.dedupKeys {
+		if k == dedupKeyFingerprint {
+			return true
+		}
+	}
+	return false
+}
+
+// allow リクエストをCoreへ送信してよいかを判定する
+//
+// 破棄すべき場合は破棄理由を返す。許可した場合は重複排除のためにリクエストを記録する
+func (l *limiter) allow(scalingReq *ScalingRequest) (bool, string) {
+	if l == nil {
+		return true, ""
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if l.dedupWindow > 0 {
+		for k, expiry := range l.seen {
+			if !now.Before(expiry) {
+				delete(l.seen, k)
+			}
+		}
+		if _, ok := l.seen[l.dedupKey(scalingReq)]; ok {
+			return false, dropReasonDuplicated
+		}
+	}
+
+	if l.rate > 0 {
+		bucket, ok := l.buckets[scalingReq.ResourceName]
+		if !ok {
+			bucket = &tokenBucket{tokens: l.burst, last: now}
+			l.buckets[scalingReq.ResourceName] = bucket
+		}
+		if !bucket.take(now, l.rate, l.burst) {
+			return false, dropReasonRateLimited
+		}
+	}
+
+	if l.dedupWindow > 0 {
+		l.seen[l.dedupKey(scalingReq)] = now.Add(l.dedupWindow)
+	}
+	return true, ""
+}
+
+// forget Coreへの送信に失敗した場合などに、再送されたリクエストが重複として破棄されないよう記録を削除する
+func (l *limiter) forget(scalingReq *ScalingRequest) {
+	if l == nil || l.dedupWindow == 0 {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.seen, l.dedupKey(scalingReq))
+}
+
+func (l *limiter) dedupKey(scalingReq *ScalingRequest) string {
+	var values []string
+	for _, k := range l.dedupKeys {
+		switch k {
+		case dedupKeyFingerprint:
+			values = append(values, scalingReq.fingerprint)
+		case dedupKeySource:
+			values = append(values, scalingReq.Source)
+		case dedupKeyResourceName:
+			values = append(values, scalingReq.ResourceName)
+		case dedupKeyRequestType:
+			values = append(values, scalingReq.RequestType)
+		case dedupKeyDesiredStateName:
+			values = append(values, scalingReq.DesiredStateName)
+		}
+	}
+	return strings.Join(values, "\x00")
+}
+
+// bodyFingerprint Fingerprinterを実装していないInput向けに、リクエストボディのハッシュ値を識別子として返す
+func bodyFingerprint(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// take 経過時間に応じてトークンを補充した上で、1つ消費できればtrueを返す
+func (b *tokenBucket) take(now time.Time, rate, burst float64) bool {
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens += elapsed * rate
+		if b.tokens > burst {
+			b.tokens = burst
+		}
+		b.last = now
+	}
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
@@ -0,0 +1,122 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inputs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_limiter_allow(t *testing.T) {
+	up := func(resourceName, fingerprint string) *ScalingRequest {
+		return &ScalingRequest{
+			Source:           "default",
+			ResourceName:     resourceName,
+			RequestType:      "up",
+			DesiredStateName: "default",
+			fingerprint:      fingerprint,
+		}
+	}
+	type call struct {
+		elapsed    time.Duration
+		req        *ScalingRequest
+		wantReason string
+	}
+
+	tests := []struct {
+		name      string
+		dedup     *DedupConfig
+		rateLimit *RateLimitConfig
+		calls     []call
+	}{
+		{
+			name:  "dedup with default keys",
+			dedup: &DedupConfig{Window: 60},
+			calls: []call{
+				{elapsed: 0, req: up("a", "")},
+				{elapsed: 10 * time.Second, req: up("a", ""), wantReason: dropReasonDuplicated},
+				{elapsed: 10 * time.Second, req: up("b", "")},
+				{elapsed: 60 * time.Second, req: up("a", "")},
+			},
+		},
+		{
+			name:  "dedup with fingerprint",
+			dedup: &DedupConfig{Window: 60, Keys: []string{"fingerprint"}},
+			calls: []call{
+				{elapsed: 0, req: up("a", "alert1")},
+				{elapsed: 0, req: up("b", "alert1"), wantReason: dropReasonDuplicated},
+				{elapsed: 0, req: up("a", "alert2")},
+			},
+		},
+		{
+			name:      "rate limit per resource",
+			rateLimit: &RateLimitConfig{Rate: 0.1, Burst: 2},
+			calls: []call{
+				{elapsed: 0, req: up("a", "")},
+				{elapsed: 0, req: up("a", "")},
+				{elapsed: 0, req: up("a", ""), wantReason: dropReasonRateLimited},
+				{elapsed: 0, req: up("b", "")},
+				{elapsed: 10 * time.Second, req: up("a", "")},
+				{elapsed: 10 * time.Second, req: up("a", ""), wantReason: dropReasonRateLimited},
+			},
+		},
+		{
+			name:      "rate limited requests are not recorded for dedup",
+			dedup:     &DedupConfig{Window: 60, Keys: []string{"fingerprint"}},
+			rateLimit: &RateLimitConfig{Rate: 0.1, Burst: 1},
+			calls: []call{
+				{elapsed: 0, req: up("a", "alert1")},
+				{elapsed: 0, req: up("a", "alert2"), wantReason: dropReasonRateLimited},
+				{elapsed: 10 * time.Second, req: up("a", "alert2")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+			l := newLimiter(tt.dedup, tt.rateLimit)
+			for i, c := range tt.calls {
+				l.now = func() time.Time { return now.Add(c.elapsed) }
+				allowed, reason := l.allow(c.req)
+				require.Equal(t, c.wantReason == "", allowed, "calls[%d]", i)
+				require.Equal(t, c.wantReason, reason, "calls[%d]", i)
+			}
+		})
+	}
+}
+
+func Test_limiter_forget(t *testing.T) {
+	l := newLimiter(&DedupConfig{Window: 60}, nil)
+	req := &ScalingRequest{ResourceName: "a", RequestType: "up"}
+
+	allowed, _ := l.allow(req)
+	require.True(t, allowed)
+
+	l.forget(req)
+	allowed, _ = l.allow(req)
+	require.True(t, allowed)
+}
+
+func Test_limiter_nil(t *testing.T) {
+	var l *limiter
+	require.Nil(t, newLimiter(nil, nil))
+	require.False(t, l.needFingerprint())
+
+	allowed, reason := l.allow(&ScalingRequest{RequestType: "up"})
+	require.True(t, allowed)
+	require.Empty(t, reason)
+}
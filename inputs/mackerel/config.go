@@ -0,0 +1,90 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mackerel
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/goccy/go-yaml"
+	"github.com/sacloud/autoscaler/inputs"
+)
+
+// MappingConfig Mackerelのアラートからリクエストパラメータへのマッピング設定
+type MappingConfig struct {
+	// Rules マッピングルールのリスト、上から順に評価され最初にマッチしたものが利用される
+	Rules []*MappingRule `yaml:"rules"`
+}
+
+// MappingRule モニター名/ホスト名とリソース名/DesiredStateNameの対応
+type MappingRule struct {
+	// MonitorName マッチさせるモニター名(inputs.MatchPatternのパターン)、省略時は全てにマッチする
+	MonitorName string `yaml:"monitor_name"`
+	// HostName マッチさせるホスト名(inputs.MatchPatternのパターン)、省略時は全てにマッチする
+	HostName string `yaml:"host_name"`
+
+	// ResourceName マッチした場合に利用するリソース名、省略時はクエリストリングの値を利用する
+	ResourceName string `yaml:"resource_name"`
+	// DesiredStateName マッチした場合に利用するDesiredStateName、省略時はクエリストリングの値を利用する
+	DesiredStateName string `yaml:"desired_state_name"`
+}
+
+// LoadMappingConfigFromPath 指定のパスからMappingConfigをロードする
+func LoadMappingConfigFromPath(filePath string) (*MappingConfig, error) {
+	if filePath == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(filePath) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+	c := &MappingConfig{}
+	if err := yaml.UnmarshalWithOptions(data, c, yaml.Strict()); err != nil {
+		return nil, err
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Validate パターンの書式を検証する
+func (c *MappingConfig) Validate() error {
+	for i, rule := range c.Rules {
+		for _, pattern := range []string{rule.MonitorName, rule.HostName} {
+			if err := inputs.ValidatePattern(pattern); err != nil {
+				return fmt.Errorf("rules[%d]: %s", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Find モニター名とホスト名にマッチするルールを返す
+func (c *MappingConfig) Find(monitorName, hostName string) *MappingRule {
+	if c == nil {
+		return nil
+	}
+	for _, rule := range c.Rules {
+		if rule.match(monitorName, hostName) {
+			return rule
+		}
+	}
+	return nil
+}
+
+func (r *MappingRule) match(monitorName, hostName string) bool {
+	return inputs.MatchPattern(r.MonitorName, monitorName) && inputs.MatchPattern(r.HostName, hostName)
+}
@@ -0,0 +1,161 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mackerel
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/sacloud/autoscaler/inputs"
+	"github.com/sacloud/autoscaler/version"
+)
+
+type Input struct {
+	inputs.ScalingRequestBuilderBase
+
+	dest       string
+	addr       string
+	configPath string
+	logger     *slog.Logger
+
+	acceptAlertStatuses []string
+	mapping             *MappingConfig
+}
+
+func NewInput(dest, addr, configPath string, logger *slog.Logger, acceptAlertStatuses []string, mapping *MappingConfig) *Input {
+	return &Input{
+		dest:       dest,
+		addr:       addr,
+		configPath: configPath,
+		logger:     logger,
+
+		acceptAlertStatuses: acceptAlertStatuses,
+		mapping:             mapping,
+	}
+}
+
+func (in *Input) Name() string {
+	return "mackerel"
+}
+
+func (in *Input) Version() string {
+	return version.FullVersion()
+}
+
+func (in *Input) Destination() string {
+	return in.dest
+}
+
+func (in *Input) ListenAddress() string {
+	return in.addr
+}
+
+func (in *Input) ConfigPath() string {
+	return in.configPath
+}
+
+func (in *Input) GetLogger() *slog.Logger {
+	return in.logger
+}
+
+// BuildScalingRequest inputs.ScalingRequestBuilderの実装
+//
+// オープン状態のアラートのみ受け入れ、マッピング設定にマッチした場合はその値をクエリストリングの値より優先する
+func (in *Input) BuildScalingRequest(req *http.Request, base *inputs.ScalingRequest) (*inputs.ScalingRequest, error) {
+	received, err := in.parseBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if received == nil || !in.accept(received) {
+		return nil, nil
+	}
+
+	scalingReq := *base
+	scalingReq.PayloadRef = received.Alert.URL
+	if rule := in.mapping.Find(received.Alert.MonitorName, received.hostName()); rule != nil {
+		if rule.ResourceName != "" {
+			scalingReq.ResourceName = rule.ResourceName
+		}
+		if rule.DesiredStateName != "" {
+			scalingReq.DesiredStateName = rule.DesiredStateName
+		}
+	}
+
+	in.logger.Info("alert accepted",
+		slog.String("alert-id", received.Alert.ID),
+		slog.String("status", received.Alert.Status),
+		slog.String("monitor", received.Alert.MonitorName),
+		slog.String("host", received.hostName()),
+		slog.Float64("metric-value", received.Alert.MetricValue),
+	)
+	return &scalingReq, nil
+}
+
+func (in *Input) parseBody(req *http.Request) (*mackerelWebhookBody, error) {
+	var received mackerelWebhookBody
+	if ok, err := inputs.DecodeJSONBody(req, &received, http.MethodPost); !ok || err != nil {
+		return nil, err
+	}
+	return &received, nil
+}
+
+func (in *Input) accept(received *mackerelWebhookBody) bool {
+	// テスト通知(event: sample)などアラート以外のイベントは無視する
+	if received.Event != "alert" || received.Alert == nil || !received.Alert.IsOpen {
+		return false
+	}
+	for _, s := range in.acceptAlertStatuses {
+		if s == received.Alert.Status {
+			return true
+		}
+	}
+	return false
+}
+
+// mackerelWebhookBody MackerelのWebhook通知のペイロード
+//
+// see: https://mackerel.io/ja/docs/entry/howto/alerts/webhook
+type mackerelWebhookBody struct {
+	OrgName string         `json:"orgName"`
+	Event   string         `json:"event"`
+	Host    *mackerelHost  `json:"host"`
+	Alert   *mackerelAlert `json:"alert"`
+	Memo    string         `json:"memo"`
+}
+
+type mackerelHost struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+type mackerelAlert struct {
+	ID          string  `json:"id"`
+	Status      string  `json:"status"` // ok, warning, critical, unknown
+	IsOpen      bool    `json:"isOpen"`
+	Trigger     string  `json:"trigger"`
+	MonitorName string  `json:"monitorName"`
+	MetricLabel string  `json:"metricLabel"`
+	MetricValue float64 `json:"metricValue"`
+	URL         string  `json:"url"`
+}
+
+// hostName ホスト名を返す、サービスメトリック監視など対象ホストがない場合は空文字を返す
+func (b *mackerelWebhookBody) hostName() string {
+	if b.Host == nil {
+		return ""
+	}
+	return b.Host.Name
+}
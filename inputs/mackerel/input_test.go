@@ -0,0 +1,159 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mackerel
+
+import (
+	"bytes"
+	_ "embed"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sacloud/autoscaler/inputs"
+	"github.com/sacloud/autoscaler/test"
+	"github.com/stretchr/testify/require"
+)
+
+//go:embed test/webhook.json
+var webhookBody []byte
+
+func TestInput_BuildScalingRequest(t *testing.T) {
+	base := &inputs.ScalingRequest{
+		Source:           "default",
+		ResourceName:     "default",
+		RequestType:      "up",
+		DesiredStateName: "default",
+	}
+	mapping, err := LoadMappingConfigFromPath("test/mapping.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		method   string
+		body     string
+		statuses []string
+		mapping  *MappingConfig
+		want     *inputs.ScalingRequest
+		wantErr  bool
+	}{
+		{
+			name:     "critical",
+			method:   http.MethodPost,
+			body:     string(webhookBody),
+			statuses: []string{"critical"},
+			want: &inputs.ScalingRequest{
+				Source:           "default",
+				ResourceName:     "default",
+				RequestType:      "up",
+				DesiredStateName: "default",
+				PayloadRef:       "https://mackerel.io/orgs/.../alerts/2bj...",
+			},
+		},
+		{
+			name:     "not POST",
+			method:   http.MethodGet,
+			body:     string(webhookBody),
+			statuses: []string{"critical"},
+			want:     nil,
+		},
+		{
+			name:     "warning is not accepted",
+			method:   http.MethodPost,
+			body:     `{"event":"alert", "alert":{"status":"warning", "isOpen":true}}`,
+			statuses: []string{"critical"},
+			want:     nil,
+		},
+		{
+			name:     "warning is accepted",
+			method:   http.MethodPost,
+			body:     `{"event":"alert", "alert":{"status":"warning", "isOpen":true}}`,
+			statuses: []string{"critical", "warning"},
+			want:     base,
+		},
+		{
+			name:     "closed",
+			method:   http.MethodPost,
+			body:     `{"event":"alert", "alert":{"status":"ok", "isOpen":false}}`,
+			statuses: []string{"critical", "warning"},
+			want:     nil,
+		},
+		{
+			name:     "sample",
+			method:   http.MethodPost,
+			body:     `{"event":"sample", "message":"This is a sample webhook."}`,
+			statuses: []string{"critical", "warning"},
+			want:     nil,
+		},
+		{
+			name:     "mapped by monitor and host name",
+			method:   http.MethodPost,
+			body:     string(webhookBody),
+			statuses: []string{"critical"},
+			mapping:  mapping,
+			want: &inputs.ScalingRequest{
+				Source:           "default",
+				ResourceName:     "web",
+				RequestType:      "up",
+				DesiredStateName: "large",
+				PayloadRef:       "https://mackerel.io/orgs/.../alerts/2bj...",
+			},
+		},
+		{
+			name:     "mapped by monitor name without host",
+			method:   http.MethodPost,
+			body:     `{"event":"alert", "alert":{"status":"critical", "isOpen":true, "monitorName":"connections"}}`,
+			statuses: []string{"critical"},
+			mapping:  mapping,
+			want: &inputs.ScalingRequest{
+				Source:           "default",
+				ResourceName:     "elb",
+				RequestType:      "up",
+				DesiredStateName: "default",
+			},
+		},
+		{
+			name:     "not mapped",
+			method:   http.MethodPost,
+			body:     `{"event":"alert", "host":{"name":"db01"}, "alert":{"status":"critical", "isOpen":true, "monitorName":"loadavg5"}}`,
+			statuses: []string{"critical"},
+			mapping:  mapping,
+			want:     base,
+		},
+		{
+			name:     "invalid json",
+			method:   http.MethodPost,
+			body:     `{`,
+			statuses: []string{"critical"},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := NewInput("", "", "", test.Logger, tt.statuses, tt.mapping)
+			req := httptest.NewRequest(tt.method, "/up", bytes.NewReader([]byte(tt.body)))
+
+			got, err := in.BuildScalingRequest(req, base)
+			require.Equal(t, tt.wantErr, err != nil, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMappingConfig_Validate(t *testing.T) {
+	c := &MappingConfig{Rules: []*MappingRule{{MonitorName: "["}}}
+	require.Error(t, c.Validate())
+}
@@ -24,6 +24,12 @@ var (
 	counter     *prometheus.CounterVec
 	upCounter   *prometheus.CounterVec
 	downCounter *prometheus.CounterVec
+	wakeCounter *prometheus.CounterVec
+
+	retryCounter        *prometheus.CounterVec
+	spoolDepth          prometheus.Gauge
+	spoolDroppedCounter *prometheus.CounterVec
+	droppedCounter      *prometheus.CounterVec
 )
 
 func initMetrics() {
@@ -53,6 +59,45 @@ func initMetrics() {
 		[]string{"code"},
 	)
 
+	wakeCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "sacloud_autoscaler_webhook_requests_wake",
+			Help: "A counter for requests to the /wake endpoint",
+		},
+		[]string{"code"},
+	)
+
+	retryCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "sacloud_autoscaler_inputs_delivery_retries_total",
+			Help: "A counter for retries of requests to the Core server",
+		},
+		[]string{"request_type"},
+	)
+
+	spoolDepth = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "sacloud_autoscaler_inputs_spool_depth",
+			Help: "The number of requests waiting in the spool",
+		},
+	)
+
+	spoolDroppedCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "sacloud_autoscaler_inputs_spool_dropped_total",
+			Help: "A counter for spooled requests dropped without delivery",
+		},
+		[]string{"reason"},
+	)
+
+	droppedCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "sacloud_autoscaler_webhook_requests_dropped_total",
+			Help: "A counter for webhook requests dropped by deduplication or rate limiting",
+		},
+		[]string{"reason", "request_type"},
+	)
+
 	counter.WithLabelValues("200")
 	counter.WithLabelValues("400")
 	counter.WithLabelValues("500")
@@ -64,4 +109,17 @@ func initMetrics() {
 	downCounter.WithLabelValues("200")
 	downCounter.WithLabelValues("400")
 	downCounter.WithLabelValues("500")
+
+	retryCounter.WithLabelValues("up")
+	retryCounter.WithLabelValues("down")
+	retryCounter.WithLabelValues("keep")
+
+	spoolDroppedCounter.WithLabelValues("expired")
+	spoolDroppedCounter.WithLabelValues("rejected")
+	spoolDroppedCounter.WithLabelValues("invalid")
+
+	for _, reason := range []string{dropReasonDuplicated, dropReasonRateLimited} {
+		droppedCounter.WithLabelValues(reason, "up")
+		droppedCounter.WithLabelValues(reason, "down")
+	}
 }
@@ -0,0 +1,158 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package newrelic
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/sacloud/autoscaler/inputs"
+	"github.com/sacloud/autoscaler/version"
+)
+
+// acceptStates 受け入れるIssueのstateの値
+var acceptStates = []string{"ACTIVATED"}
+
+type Input struct {
+	inputs.ScalingRequestBuilderBase
+
+	dest       string
+	addr       string
+	configPath string
+	logger     *slog.Logger
+}
+
+func NewInput(dest, addr, configPath string, logger *slog.Logger) *Input {
+	return &Input{
+		dest:       dest,
+		addr:       addr,
+		configPath: configPath,
+		logger:     logger,
+	}
+}
+
+func (in *Input) Name() string {
+	return "newrelic"
+}
+
+func (in *Input) Version() string {
+	return version.FullVersion()
+}
+
+func (in *Input) Destination() string {
+	return in.dest
+}
+
+func (in *Input) ListenAddress() string {
+	return in.addr
+}
+
+func (in *Input) ConfigPath() string {
+	return in.configPath
+}
+
+func (in *Input) GetLogger() *slog.Logger {
+	return in.logger
+}
+
+// BuildScalingRequest inputs.ScalingRequestBuilderの実装
+//
+// ACTIVATEDなIssueのみ受け入れ、ラベルが指定されていた場合はクエリストリングの値より優先する
+func (in *Input) BuildScalingRequest(req *http.Request, base *inputs.ScalingRequest) (*inputs.ScalingRequest, error) {
+	received, err := in.parseBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if received == nil || !received.isActivated() {
+		return nil, nil
+	}
+
+	in.logger.Info("issue accepted",
+		slog.String("issue-id", received.ID),
+		slog.String("state", received.State),
+		slog.String("title", received.Title),
+	)
+	labels := received.labels()
+	return base.WithTags(labels).WithLabels(labels), nil
+}
+
+func (in *Input) parseBody(req *http.Request) (*newRelicWebhookBody, error) {
+	var received newRelicWebhookBody
+	if ok, err := inputs.DecodeJSONBody(req, &received, http.MethodPost); !ok || err != nil {
+		return nil, err
+	}
+	return &received, nil
+}
+
+// newRelicWebhookBody New RelicのWorkflowsからのWebhook通知のペイロード
+//
+// New Relic側ではペイロードテンプレートに以下のようにlabelsを含めておく必要がある
+//
+//	{
+//	  "id": {{ json issueId }},
+//	  "title": {{ json annotations.title.[0] }},
+//	  "priority": {{ json priority }},
+//	  "state": {{ json state }},
+//	  "labels": {{ json accumulations.tag }}
+//	}
+//
+// see: https://docs.newrelic.com/docs/alerts-applied-intelligence/notifications/notification-integrations/#webhook
+type newRelicWebhookBody struct {
+	ID       string                        `json:"id"`
+	Title    string                        `json:"title"`
+	Priority string                        `json:"priority"` // CRITICAL, HIGH, MEDIUM, LOW
+	State    string                        `json:"state"`    // CREATED, ACTIVATED, ACKNOWLEDGED, CLOSED
+	Labels   map[string]newRelicLabelValue `json:"labels"`
+}
+
+func (b *newRelicWebhookBody) isActivated() bool {
+	for _, s := range acceptStates {
+		if s == b.State {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *newRelicWebhookBody) labels() map[string]string {
+	labels := make(map[string]string)
+	for k, v := range b.Labels {
+		labels[k] = string(v)
+	}
+	return labels
+}
+
+// newRelicLabelValue ラベルの値
+//
+// accumulations.tagの値は配列となるため、文字列と配列のどちらも受け付け配列の場合は先頭の値を利用する
+type newRelicLabelValue string
+
+func (v *newRelicLabelValue) UnmarshalJSON(data []byte) error {
+	var values []string
+	if err := json.Unmarshal(data, &values); err == nil {
+		if len(values) > 0 {
+			*v = newRelicLabelValue(values[0])
+		}
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*v = newRelicLabelValue(value)
+	return nil
+}
@@ -0,0 +1,119 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package newrelic
+
+import (
+	"bytes"
+	_ "embed"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sacloud/autoscaler/inputs"
+	"github.com/sacloud/autoscaler/test"
+	"github.com/stretchr/testify/require"
+)
+
+//go:embed test/webhook.json
+var webhookBody []byte
+
+func TestInput_BuildScalingRequest(t *testing.T) {
+	base := &inputs.ScalingRequest{
+		Source:           "default",
+		ResourceName:     "default",
+		RequestType:      "up",
+		DesiredStateName: "default",
+	}
+
+	tests := []struct {
+		name    string
+		method  string
+		body    string
+		want    *inputs.ScalingRequest
+		wantErr bool
+	}{
+		{
+			name:   "activated",
+			method: http.MethodPost,
+			body:   string(webhookBody),
+			want: &inputs.ScalingRequest{
+				Source:           "default",
+				ResourceName:     "web",
+				RequestType:      "up",
+				DesiredStateName: "large",
+				Labels: map[string]string{
+					"env":                           "production",
+					"autoscaler_resource_name":      "web",
+					"autoscaler_desired_state_name": "large",
+				},
+			},
+		},
+		{
+			name:   "activated with string labels",
+			method: http.MethodPost,
+			body:   `{"state":"ACTIVATED", "labels":{"autoscaler_request_type":"down", "autoscaler_source":"newrelic"}}`,
+			want: &inputs.ScalingRequest{
+				Source:           "newrelic",
+				ResourceName:     "default",
+				RequestType:      "up",
+				DesiredStateName: "default",
+				Labels: map[string]string{
+					"autoscaler_request_type": "down",
+					"autoscaler_source":       "newrelic",
+				},
+			},
+		},
+		{
+			name:   "activated without labels",
+			method: http.MethodPost,
+			body:   `{"state":"ACTIVATED"}`,
+			want:   base,
+		},
+		{
+			name:   "closed",
+			method: http.MethodPost,
+			body:   `{"state":"CLOSED", "labels":{"autoscaler_resource_name":["web"]}}`,
+			want:   nil,
+		},
+		{
+			name:   "acknowledged",
+			method: http.MethodPost,
+			body:   `{"state":"ACKNOWLEDGED"}`,
+			want:   nil,
+		},
+		{
+			name:   "not POST",
+			method: http.MethodGet,
+			body:   string(webhookBody),
+			want:   nil,
+		},
+		{
+			name:    "invalid label value",
+			method:  http.MethodPost,
+			body:    `{"state":"ACTIVATED", "labels":{"autoscaler_resource_name":1}}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := NewInput("", "", "", test.Logger)
+			req := httptest.NewRequest(tt.method, "/up", bytes.NewReader([]byte(tt.body)))
+
+			got, err := in.BuildScalingRequest(req, base)
+			require.Equal(t, tt.wantErr, err != nil, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inputs
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// MatchPattern vがpatternにマッチするかを判定する
+//
+// patternはpath.Matchの形式で指定する。ただしpath.Matchと異なり*や?は/にもマッチする。
+// patternが空の場合は全てにマッチする
+func MatchPattern(pattern, v string) bool {
+	if pattern == "" {
+		return true
+	}
+	// path.Matchは/を区切り文字として扱うため、パターンと値の双方で/を区切り文字以外に置き換えて判定する
+	matched, _ := path.Match(strings.ReplaceAll(pattern, "/", "\x00"), strings.ReplaceAll(v, "/", "\x00")) // ValidatePatternで検証済み
+	return matched
+}
+
+// ValidatePattern MatchPatternに指定するパターンの書式を検証する
+func ValidatePattern(pattern string) error {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return fmt.Errorf("invalid pattern %q: %s", pattern, err)
+	}
+	return nil
+}
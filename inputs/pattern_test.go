@@ -0,0 +1,48 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inputs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		v       string
+		want    bool
+	}{
+		{pattern: "", v: "web", want: true},
+		{pattern: "web-*", v: "web-01", want: true},
+		{pattern: "web-?", v: "web-1", want: true},
+		{pattern: "web-*", v: "db-01", want: false},
+		{pattern: "*.example.com/*", v: "www.example.com/api/healthz", want: true},
+		{pattern: "team/*", v: "team/a/b", want: true},
+		{pattern: "team/[ab]", v: "team/a", want: true},
+		{pattern: "team/a", v: "team-a", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.v, func(t *testing.T) {
+			require.Equal(t, tt.want, MatchPattern(tt.pattern, tt.v))
+		})
+	}
+}
+
+func TestValidatePattern(t *testing.T) {
+	require.NoError(t, ValidatePattern("web-*"))
+	require.Error(t, ValidatePattern("["))
+}
@@ -0,0 +1,127 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/goccy/go-yaml"
+	"github.com/sacloud/autoscaler/inputs"
+	"github.com/sacloud/autoscaler/validate"
+)
+
+const defaultInterval = 30
+
+// Config Prometheusへのクエリと閾値の設定
+type Config struct {
+	// Address PrometheusのHTTP APIのアドレス 例: http://localhost:9090
+	Address string `yaml:"address" validate:"required,url"`
+	// Interval クエリを実行する間隔(秒数)、省略時は30秒
+	Interval int `yaml:"interval" validate:"omitempty,min=1"`
+	// Rules クエリと閾値のリスト
+	Rules []*Rule `yaml:"rules" validate:"required,min=1,dive"`
+}
+
+// Rule PromQLのクエリと、その結果に応じたリクエスト内容の定義
+//
+// クエリの結果がUpperより大きい状態がDuration秒継続したらUp、
+// Lowerより小さい状態がDuration秒継続したらDownをCoreへリクエストする。
+// UpperとLowerを省略した場合はTargetとToleranceから算出する
+type Rule struct {
+	// Name ルール名、ログ出力や閾値超過の継続状態の管理に利用するためルール間で一意である必要がある
+	Name string `yaml:"name" validate:"required"`
+	// Query PromQLのクエリ、単一の値を返すものを指定する
+	Query string `yaml:"query" validate:"required"`
+
+	Source           string `yaml:"source" validate:"omitempty,printascii,max=1024"`
+	ResourceName     string `yaml:"resource_name" validate:"omitempty,printascii,max=1024"`
+	DesiredStateName string `yaml:"desired_state_name" validate:"omitempty,printascii,max=1024"`
+
+	// Target 目標値
+	Target *float64 `yaml:"target"`
+	// Tolerance Targetからの許容範囲(比率)、省略時は0.1(±10%)
+	Tolerance *float64 `yaml:"tolerance" validate:"omitempty,gte=0"`
+	// Upper 上限値、省略時はTarget*(1+Tolerance)
+	Upper *float64 `yaml:"upper"`
+	// Lower 下限値、省略時はTarget*(1-Tolerance)
+	Lower *float64 `yaml:"lower"`
+
+	// Duration 閾値を超えた状態がこの秒数継続したらリクエストを行う
+	Duration int `yaml:"duration" validate:"omitempty,min=0"`
+	// SendKeep 値がUpper/Lowerの範囲内の状態がDuration秒継続したらKeepをリクエストするか
+	SendKeep bool `yaml:"send_keep"`
+}
+
+// LoadConfigFromPath 指定のパスからConfigをロードする
+func LoadConfigFromPath(path string) (*Config, error) {
+	data, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+	c := &Config{}
+	if err := yaml.UnmarshalWithOptions(data, c, yaml.Strict()); err != nil {
+		return nil, err
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Config) Validate() error {
+	if err := validate.Struct(c); err != nil {
+		return err
+	}
+	names := make(map[string]struct{})
+	for _, rule := range c.Rules {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+		if _, ok := names[rule.Name]; ok {
+			return fmt.Errorf("rule %q: name must be unique", rule.Name)
+		}
+		names[rule.Name] = struct{}{}
+	}
+	return nil
+}
+
+func (c *Config) interval() time.Duration {
+	if c.Interval <= 0 {
+		return defaultInterval * time.Second
+	}
+	return time.Duration(c.Interval) * time.Second
+}
+
+func (r *Rule) Validate() error {
+	upper, lower := r.thresholds()
+	if upper == nil && lower == nil {
+		return fmt.Errorf("rule %q: one of target/upper/lower is required", r.Name)
+	}
+	if upper != nil && lower != nil && *upper < *lower {
+		return fmt.Errorf("rule %q: upper(%v) must be greater than or equal to lower(%v)", r.Name, *upper, *lower)
+	}
+	return nil
+}
+
+// thresholds 上限値と下限値を返す、未指定の場合はnil
+func (r *Rule) thresholds() (upper, lower *float64) {
+	return inputs.Thresholds(r.Target, r.Tolerance, r.Upper, r.Lower)
+}
+
+func (r *Rule) duration() time.Duration {
+	return time.Duration(r.Duration) * time.Second
+}
@@ -0,0 +1,224 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"github.com/sacloud/autoscaler/defaults"
+	"github.com/sacloud/autoscaler/inputs"
+	"github.com/sacloud/autoscaler/version"
+)
+
+// Input Prometheusへ定期的にクエリを実行し、結果に応じてCoreへリクエストを行うInput
+//
+// Webhookは受け付けない
+type Input struct {
+	dest       string
+	addr       string
+	configPath string
+	logger     *slog.Logger
+
+	config *Config
+	client promv1.API
+
+	mu     sync.Mutex
+	states map[string]*ruleState
+}
+
+// ruleState ルールごとの判定状態
+type ruleState struct {
+	requestType string    // 直近の判定結果
+	since       time.Time // requestTypeの状態が始まった時刻
+}
+
+func NewInput(dest, addr, configPath string, logger *slog.Logger, config *Config) (*Input, error) {
+	client, err := api.NewClient(api.Config{Address: config.Address})
+	if err != nil {
+		return nil, err
+	}
+	return &Input{
+		dest:       dest,
+		addr:       addr,
+		configPath: configPath,
+		logger:     logger,
+
+		config: config,
+		client: promv1.NewAPI(client),
+		states: make(map[string]*ruleState),
+	}, nil
+}
+
+func (in *Input) Name() string {
+	return "prometheus"
+}
+
+func (in *Input) Version() string {
+	return version.FullVersion()
+}
+
+func (in *Input) Destination() string {
+	return in.dest
+}
+
+func (in *Input) ListenAddress() string {
+	return in.addr
+}
+
+func (in *Input) ConfigPath() string {
+	return in.configPath
+}
+
+func (in *Input) GetLogger() *slog.Logger {
+	return in.logger
+}
+
+func (in *Input) ShouldAccept(_ *http.Request) (bool, error) {
+	return false, nil
+}
+
+// Poll inputs.Pollerの実装
+func (in *Input) Poll(ctx context.Context, sender inputs.Sender) error {
+	ticker := time.NewTicker(in.config.interval())
+	defer ticker.Stop()
+
+	in.logger.Info("polling started", slog.String("address", in.config.Address), slog.Duration("interval", in.config.interval()))
+	for {
+		in.evaluateAll(ctx, sender, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (in *Input) evaluateAll(ctx context.Context, sender inputs.Sender, now time.Time) {
+	for _, rule := range in.config.Rules {
+		if err := in.evaluate(ctx, sender, rule, now); err != nil {
+			in.logger.Error("evaluating rule failed", slog.String("rule", rule.Name), slog.Any("error", err))
+		}
+	}
+}
+
+func (in *Input) evaluate(ctx context.Context, sender inputs.Sender, rule *Rule, now time.Time) error {
+	value, err := in.query(ctx, rule.Query, now)
+	if err != nil {
+		return err
+	}
+
+	requestType := in.requestTypeFor(rule, value, now)
+	if requestType == "" {
+		return nil
+	}
+
+	scalingReq := &inputs.ScalingRequest{
+		Source:           rule.Source,
+		ResourceName:     rule.ResourceName,
+		RequestType:      requestType,
+		DesiredStateName: rule.DesiredStateName,
+	}
+	if scalingReq.Source == "" {
+		scalingReq.Source = defaults.SourceName
+	}
+	if scalingReq.ResourceName == "" {
+		scalingReq.ResourceName = defaults.ResourceName
+	}
+
+	in.logger.Info("sending request to the Core server",
+		slog.String("rule", rule.Name),
+		slog.String("request-type", requestType),
+		slog.Float64("value", value),
+	)
+	res, err := sender.Send(ctx, scalingReq)
+	if err != nil {
+		var dropped *inputs.DroppedError
+		if errors.As(err, &dropped) {
+			in.logger.Info("request dropped", slog.String("rule", rule.Name), slog.String("reason", dropped.Reason))
+			return nil
+		}
+		return err
+	}
+	in.logger.Info("request handled",
+		slog.String("rule", rule.Name),
+		slog.String("status", res.Status.String()),
+		slog.String("job-id", res.ScalingJobId),
+		slog.String("job-message", res.Message),
+	)
+	return nil
+}
+
+// requestTypeFor 値と継続時間からリクエスト種別を判定する、リクエスト不要な場合は空文字を返す
+func (in *Input) requestTypeFor(rule *Rule, value float64, now time.Time) string {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+
+	requestType := "keep"
+	upper, lower := rule.thresholds()
+	switch {
+	case upper != nil && value > *upper:
+		requestType = "up"
+	case lower != nil && value < *lower:
+		requestType = "down"
+	}
+
+	state, ok := in.states[rule.Name]
+	if !ok || state.requestType != requestType {
+		state = &ruleState{requestType: requestType, since: now}
+		in.states[rule.Name] = state
+	}
+	if now.Sub(state.since) < rule.duration() {
+		return ""
+	}
+	// 同じ状態が続く場合は再度Durationが経過するまでリクエストしない
+	state.since = now
+
+	if requestType == "keep" && !rule.SendKeep {
+		return ""
+	}
+	return requestType
+}
+
+func (in *Input) query(ctx context.Context, query string, now time.Time) (float64, error) {
+	result, warnings, err := in.client.Query(ctx, query, now)
+	if err != nil {
+		return 0, err
+	}
+	for _, w := range warnings {
+		in.logger.Warn("query returned warning", slog.String("query", query), slog.String("warning", w))
+	}
+
+	switch v := result.(type) {
+	case *model.Scalar:
+		return float64(v.Value), nil
+	case model.Vector:
+		if len(v) != 1 {
+			return 0, fmt.Errorf("query must return a single value, but got %d values: %s", len(v), query)
+		}
+		return float64(v[0].Value), nil
+	default:
+		return 0, fmt.Errorf("unsupported result type %q: %s", result.Type(), query)
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sacloud/autoscaler/inputs"
+	"github.com/sacloud/autoscaler/request"
+	"github.com/sacloud/autoscaler/test"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSender struct {
+	requests []*inputs.ScalingRequest
+}
+
+func (s *fakeSender) Send(_ context.Context, req *inputs.ScalingRequest) (*request.ScalingResponse, error) {
+	s.requests = append(s.requests, req)
+	return &request.ScalingResponse{Status: request.ScalingJobStatus_JOB_ACCEPTED}, nil
+}
+
+func fakePrometheus(t *testing.T, value *string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		require.Equal(t, "/api/v1/query", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000,"%s"]}]}}`, *value) //nolint:errcheck
+	}))
+}
+
+func TestLoadConfigFromPath(t *testing.T) {
+	c, err := LoadConfigFromPath("test/config.yaml")
+	require.NoError(t, err)
+	require.Len(t, c.Rules, 1)
+	require.Equal(t, 10*time.Second, c.interval())
+
+	upper, lower := c.Rules[0].thresholds()
+	require.InDelta(t, 0.6, *upper, 0.0001)
+	require.InDelta(t, 0.4, *lower, 0.0001)
+}
+
+func TestRule_Validate(t *testing.T) {
+	v := func(f float64) *float64 { return &f }
+
+	require.Error(t, (&Rule{Name: "empty"}).Validate())
+	require.Error(t, (&Rule{Name: "reversed", Upper: v(1), Lower: v(2)}).Validate())
+	require.NoError(t, (&Rule{Name: "upper only", Upper: v(1)}).Validate())
+	require.NoError(t, (&Rule{Name: "target", Target: v(1)}).Validate())
+}
+
+func TestConfig_Validate_duplicatedRuleName(t *testing.T) {
+	v := func(f float64) *float64 { return &f }
+	c := &Config{
+		Address: "http://localhost:9090",
+		Rules: []*Rule{
+			{Name: "cpu", Query: "q1", Upper: v(1)},
+			{Name: "cpu", Query: "q2", Upper: v(2)},
+		},
+	}
+	require.Error(t, c.Validate())
+
+	c.Rules[1].Name = "memory"
+	require.NoError(t, c.Validate())
+}
+
+func TestInput_evaluate(t *testing.T) {
+	value := "0.5"
+	server := fakePrometheus(t, &value)
+	defer server.Close()
+
+	target := 0.5
+	rule := &Rule{
+		Name:         "web-cpu",
+		Query:        "up",
+		ResourceName: "web",
+		Target:       &target,
+		Duration:     60,
+		SendKeep:     true,
+	}
+	in, err := NewInput("", "", "", test.Logger, &Config{Address: server.URL, Rules: []*Rule{rule}})
+	require.NoError(t, err)
+
+	sender := &fakeSender{}
+	now := time.Now()
+	ctx := context.Background()
+	steps := []struct {
+		value   string
+		elapsed time.Duration
+		want    string
+	}{
+		{value: "0.9", elapsed: 0, want: ""},                  // 上限超過: 開始
+		{value: "0.9", elapsed: 30 * time.Second, want: ""},   // 上限超過: 継続時間未満
+		{value: "0.9", elapsed: 60 * time.Second, want: "up"}, // 上限超過: 継続時間経過
+		{value: "0.9", elapsed: 90 * time.Second, want: ""},   // リクエスト後は再度継続時間が経過するまで待つ
+		{value: "0.1", elapsed: 100 * time.Second, want: ""},  // 下限未満: 開始
+		{value: "0.5", elapsed: 130 * time.Second, want: ""},  // 範囲内: 開始(下限未満の状態はリセット)
+		{value: "0.1", elapsed: 160 * time.Second, want: ""},  // 下限未満: 開始
+		{value: "0.1", elapsed: 220 * time.Second, want: "down"},
+		{value: "0.5", elapsed: 230 * time.Second, want: ""},
+		{value: "0.5", elapsed: 290 * time.Second, want: "keep"},
+	}
+	for i, step := range steps {
+		value = step.value
+		sender.requests = nil
+
+		err := in.evaluate(ctx, sender, rule, now.Add(step.elapsed))
+		require.NoError(t, err, "step %d", i)
+
+		if step.want == "" {
+			require.Empty(t, sender.requests, "step %d", i)
+			continue
+		}
+		require.Len(t, sender.requests, 1, "step %d", i)
+		require.Equal(t, &inputs.ScalingRequest{
+			Source:           "default",
+			ResourceName:     "web",
+			RequestType:      step.want,
+			DesiredStateName: "",
+		}, sender.requests[0], "step %d", i)
+	}
+}
+
+func TestInput_query_multipleValues(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[{"metric":{"a":"1"},"value":[1700000000,"1"]},{"metric":{"a":"2"},"value":[1700000000,"2"]}]}}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	in, err := NewInput("", "", "", test.Logger, &Config{Address: server.URL})
+	require.NoError(t, err)
+
+	_, err = in.query(context.Background(), "up", time.Now())
+	require.Error(t, err)
+}
@@ -15,6 +15,10 @@
 package inputs
 
 import (
+	"fmt"
+
+	"github.com/sacloud/autoscaler/defaults"
+	"github.com/sacloud/autoscaler/request"
 	"github.com/sacloud/autoscaler/validate"
 )
 
@@ -22,10 +26,94 @@ import (
 type ScalingRequest struct {
 	Source           string `name:"source" validate:"omitempty,printascii,max=1024"`
 	ResourceName     string `name:"resource-name" validate:"omitempty,printascii,max=1024"`
-	RequestType      string `name:"request-type" validate:"required,oneof=up down"`
+	RequestType      string `name:"request-type" validate:"required,oneof=up down keep"`
 	DesiredStateName string `name:"desired-state-name" validate:"omitempty,printascii,max=1024"`
+	Step             uint32 `name:"step"`
+
+	// Labels リクエストの契機となったアラートのラベルなど、Coreを経由してハンドラーへ引き渡される
+	Labels map[string]string `name:"labels" validate:"omitempty,dive,keys,printascii,max=256,endkeys,max=4096"`
+	// PayloadRef リクエストの契機となったアラートなどの元データへの参照(URLなど)
+	PayloadRef string `name:"payload-ref" validate:"omitempty,max=4096"`
+
+	// DesiredSpec 希望するスケールの明示的な指定、DesiredStateNameとは同時に指定できない
+	DesiredSpec *DesiredSpec `name:"desired-spec"`
+
+	// fingerprint 重複排除に用いるアラートの識別子、Coreへは送信しない
+	fingerprint string
 }
 
 func (r *ScalingRequest) Validate() error {
-	return validate.Struct(r)
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+	if r.DesiredSpec != nil && r.DesiredStateName != "" && r.DesiredStateName != defaults.DesiredStateName {
+		return fmt.Errorf("desired-state-name and desired-spec cannot be specified at the same time")
+	}
+	return nil
+}
+
+// DesiredSpec 希望するスケール、操作対象のリソースの種類に応じた項目を指定する
+type DesiredSpec struct {
+	Size      uint32 `name:"desired-size"`       // ServerGroup: サーバ台数
+	Core      uint32 `name:"desired-core"`       // Server: コア数
+	Memory    uint32 `name:"desired-memory"`     // Server: メモリサイズ(GiB)
+	CPS       uint32 `name:"desired-cps"`        // ELB: CPS
+	BandWidth uint32 `name:"desired-band-width"` // Router: 帯域幅(Mbps)
+}
+
+// ToRequest Coreへのリクエストパラメータへ変換する
+func (s *DesiredSpec) ToRequest() *request.DesiredSpec {
+	if s == nil {
+		return nil
+	}
+	return &request.DesiredSpec{
+		Size:      s.Size,
+		Core:      s.Core,
+		Memory:    s.Memory,
+		Cps:       s.CPS,
+		BandWidth: s.BandWidth,
+	}
+}
+
+// 監視ツール側のタグ/ラベルからリクエストパラメータを決定する際に参照するキー
+//
+// リクエスト種別はWebhookのエンドポイント(/up, /down)で決まるため、タグ/ラベルでは変更できない
+const (
+	TagKeySource           = "autoscaler_source"
+	TagKeyResourceName     = "autoscaler_resource_name"
+	TagKeyDesiredStateName = "autoscaler_desired_state_name"
+)
+
+// WithTags tagsに含まれるキーに応じて値を上書きしたScalingRequestのコピーを返す
+func (r *ScalingRequest) WithTags(tags map[string]string) *ScalingRequest {
+	req := *r
+	if v, ok := tags[TagKeySource]; ok && v != "" {
+		req.Source = v
+	}
+	if v, ok := tags[TagKeyResourceName]; ok && v != "" {
+		req.ResourceName = v
+	}
+	if v, ok := tags[TagKeyDesiredStateName]; ok && v != "" {
+		req.DesiredStateName = v
+	}
+	return &req
+}
+
+// WithLabels labelsを追加したScalingRequestのコピーを返す
+//
+// 同じキーが既に存在する場合はlabelsの値で上書きする
+func (r *ScalingRequest) WithLabels(labels map[string]string) *ScalingRequest {
+	req := *r
+	if len(labels) == 0 {
+		return &req
+	}
+	merged := make(map[string]string, len(r.Labels)+len(labels))
+	for k, v := range r.Labels {
+		merged[k] = v
+	}
+	for k, v := range labels {
+		merged[k] = v
+	}
+	req.Labels = merged
+	return &req
 }
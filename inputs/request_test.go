@@ -14,7 +14,11 @@
 
 package inputs
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
 
 func TestScalingRequest_Validate(t *testing.T) {
 	webhookBodyMaxLen = 1
@@ -120,3 +124,53 @@ func TestScalingRequest_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestScalingRequest_WithTags(t *testing.T) {
+	base := &ScalingRequest{
+		Source:           "default",
+		ResourceName:     "default",
+		RequestType:      "up",
+		DesiredStateName: "default",
+	}
+
+	got := base.WithTags(map[string]string{
+		TagKeyResourceName:        "web",
+		TagKeyDesiredStateName:    "",
+		"autoscaler_request_type": "down",
+		"env":                     "production",
+	})
+	require.Equal(t, &ScalingRequest{
+		Source:           "default",
+		ResourceName:     "web",
+		RequestType:      "up",
+		DesiredStateName: "default",
+	}, got)
+	require.Equal(t, "default", base.ResourceName, "base should not be modified")
+	require.Equal(t, "up", got.RequestType, "request type should not be overwritten by tags")
+}
+
+func TestScalingRequest_WithLabels(t *testing.T) {
+	base := &ScalingRequest{
+		Source:      "default",
+		RequestType: "up",
+		Labels:      map[string]string{"env": "staging", "host": "web01"},
+	}
+
+	got := base.WithLabels(map[string]string{"env": "production", "alertname": "HighCPU"})
+	require.Equal(t, map[string]string{
+		"env":       "production",
+		"host":      "web01",
+		"alertname": "HighCPU",
+	}, got.Labels)
+	require.Equal(t, map[string]string{"env": "staging", "host": "web01"}, base.Labels, "base should not be modified")
+
+	require.Equal(t, base, base.WithLabels(nil))
+}
+
+func TestScalingRequest_Validate_labels(t *testing.T) {
+	req := &ScalingRequest{RequestType: "up", Labels: map[string]string{"summary": "CPU使用率が高い"}}
+	require.NoError(t, req.Validate())
+
+	req = &ScalingRequest{RequestType: "up", Labels: map[string]string{"ラベル": "value"}}
+	require.Error(t, req.Validate())
+}
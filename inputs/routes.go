@@ -0,0 +1,74 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inputs
+
+// RouteConfig リクエストの内容に応じた送信先Coreの設定
+//
+// Routesは定義順に評価され、最初に一致したものの送信先が利用される。
+// いずれにも一致しない場合はInputのDestination(--destinationフラグ)へ送信する。
+// 各Inputがラベルやタグから決定したsourceやresource_nameもマッチの対象となる
+type RouteConfig struct {
+	// Source 対象とするsourceのパターン(MatchPatternの形式)、省略時は全てに一致する
+	Source string `yaml:"source"`
+	// ResourceName 対象とするresource_nameのパターン(MatchPatternの形式)、省略時は全てに一致する
+	ResourceName string `yaml:"resource_name"`
+	// Labels 対象とするラベルのキーと値のパターン(MatchPatternの形式)、省略時は全てに一致する
+	//
+	// 全てのキーが一致する必要がある。リクエストに該当するラベルが存在しない場合は一致しない
+	Labels map[string]string `yaml:"labels"`
+	// Destinations 送信先CoreのgRPCエンドポイント、複数指定した場合は全ての送信先へ送信する
+	Destinations []string `yaml:"destinations" validate:"required,min=1,dive,required"`
+}
+
+// Validate パターンの形式を検証する
+func (r *RouteConfig) Validate() error {
+	patterns := []string{r.Source, r.ResourceName}
+	for _, pattern := range r.Labels {
+		patterns = append(patterns, pattern)
+	}
+	for _, pattern := range patterns {
+		if err := ValidatePattern(pattern); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Match scalingReqがこのRouteの対象であるかを判定する
+func (r *RouteConfig) Match(scalingReq *ScalingRequest) bool {
+	return MatchPattern(r.Source, scalingReq.Source) &&
+		MatchPattern(r.ResourceName, scalingReq.ResourceName) &&
+		r.matchLabels(scalingReq.Labels)
+}
+
+func (r *RouteConfig) matchLabels(labels map[string]string) bool {
+	for key, pattern := range r.Labels {
+		v, ok := labels[key]
+		if !ok || !MatchPattern(pattern, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// findDestinations scalingReqの送信先を返す、Routesに一致しない場合は空文字(InputのDestination)のみを返す
+func findDestinations(routes []*RouteConfig, scalingReq *ScalingRequest) []string {
+	for _, r := range routes {
+		if r.Match(scalingReq) {
+			return r.Destinations
+		}
+	}
+	return []string{""}
+}
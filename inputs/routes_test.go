@@ -0,0 +1,123 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inputs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_findDestinations(t *testing.T) {
+	routes := []*RouteConfig{
+		{Labels: map[string]string{"team": "project3", "env": "prod-*"}, Destinations: []string{"unix:project3.sock"}},
+		{ResourceName: "project1-*", Destinations: []string{"unix:project1.sock"}},
+		{Source: "grafana", ResourceName: "shared", Destinations: []string{"unix:project1.sock", "unix:project2.sock"}},
+		{Source: "grafana", Destinations: []string{"unix:project2.sock"}},
+	}
+
+	tests := []struct {
+		name string
+		req  *ScalingRequest
+		want []string
+	}{
+		{
+			name: "matched by resource name",
+			req:  &ScalingRequest{Source: "default", ResourceName: "project1-web"},
+			want: []string{"unix:project1.sock"},
+		},
+		{
+			name: "fan out",
+			req:  &ScalingRequest{Source: "grafana", ResourceName: "shared"},
+			want: []string{"unix:project1.sock", "unix:project2.sock"},
+		},
+		{
+			name: "matched by source",
+			req:  &ScalingRequest{Source: "grafana", ResourceName: "web"},
+			want: []string{"unix:project2.sock"},
+		},
+		{
+			name: "matched by labels",
+			req: &ScalingRequest{
+				Source:       "default",
+				ResourceName: "project1-web",
+				Labels:       map[string]string{"team": "project3", "env": "prod-tokyo", "severity": "warning"},
+			},
+			want: []string{"unix:project3.sock"},
+		},
+		{
+			name: "partially matched labels",
+			req: &ScalingRequest{
+				Source:       "default",
+				ResourceName: "web",
+				Labels:       map[string]string{"team": "project3", "env": "staging"},
+			},
+			want: []string{""},
+		},
+		{
+			name: "missing label",
+			req: &ScalingRequest{
+				Source:       "default",
+				ResourceName: "web",
+				Labels:       map[string]string{"team": "project3"},
+			},
+			want: []string{""},
+		},
+		{
+			name: "not matched",
+			req:  &ScalingRequest{Source: "default", ResourceName: "web"},
+			want: []string{""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, findDestinations(routes, tt.req))
+		})
+	}
+}
+
+func TestConfig_Validate_routes(t *testing.T) {
+	tests := []struct {
+		name    string
+		routes  []*RouteConfig
+		wantErr bool
+	}{
+		{
+			name:   "valid",
+			routes: []*RouteConfig{{ResourceName: "web-*", Destinations: []string{"unix:autoscaler.sock"}}},
+		},
+		{
+			name:    "empty destinations",
+			routes:  []*RouteConfig{{ResourceName: "web-*"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid pattern",
+			routes:  []*RouteConfig{{ResourceName: "[", Destinations: []string{"unix:autoscaler.sock"}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid label pattern",
+			routes:  []*RouteConfig{{Labels: map[string]string{"team": "["}, Destinations: []string{"unix:autoscaler.sock"}}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&Config{Routes: tt.routes}).Validate()
+			require.Equal(t, tt.wantErr, err != nil, err)
+		})
+	}
+}
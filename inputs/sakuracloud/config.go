@@ -0,0 +1,173 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/goccy/go-yaml"
+	"github.com/sacloud/autoscaler/core"
+	"github.com/sacloud/autoscaler/inputs"
+	"github.com/sacloud/autoscaler/validate"
+)
+
+const (
+	defaultInterval = 60 // 秒
+	defaultPeriod   = 10 // 分
+)
+
+// メトリクスの種類
+const (
+	MetricServerCPU            = "server_cpu"             // サーバのCPU-TIME
+	MetricServerTrafficIn      = "server_traffic_in"      // サーバの先頭NICの受信量
+	MetricServerTrafficOut     = "server_traffic_out"     // サーバの先頭NICの送信量
+	MetricRouterIn             = "router_in"              // スイッチ+ルータの受信量
+	MetricRouterOut            = "router_out"             // スイッチ+ルータの送信量
+	MetricELBCPS               = "elb_cps"                // ELBの秒間接続数
+	MetricELBActiveConnections = "elb_active_connections" // ELBの同時接続数
+)
+
+// 複数リソースの値の集約方法
+const (
+	AggregationAvg = "avg"
+	AggregationMax = "max"
+	AggregationSum = "sum"
+)
+
+// Config さくらのクラウドのアクティビティモニタから取得した値に対する閾値ルールの設定
+type Config struct {
+	// SakuraCloud APIキーなどの設定、省略時はCoreのコンフィギュレーションの値を利用する
+	SakuraCloud *core.SakuraCloud `yaml:"sakuracloud"`
+	// Interval アクティビティモニタを参照する間隔(秒数)、省略時は60秒
+	Interval int `yaml:"interval" validate:"omitempty,min=1"`
+	// Rules 閾値ルールのリスト
+	Rules []*Rule `yaml:"rules" validate:"required,min=1,dive"`
+}
+
+// Rule 対象リソースとメトリクス、閾値の定義
+//
+// CoreのコンフィギュレーションでResourceNameという名前を持つリソース定義が対象とする全てのリソース(サーバグループのメンバーなど)の値を
+// Aggregationで集約し、集約した値がUpperより大きければUp、Lowerより小さければDownをCoreへリクエストする。
+// UpperとLowerを省略した場合はTargetとToleranceから算出する
+type Rule struct {
+	// Name ルール名、ログ出力などに利用する
+	Name string `yaml:"name" validate:"required"`
+	// Metric 参照するメトリクス
+	Metric string `yaml:"metric" validate:"required,oneof=server_cpu server_traffic_in server_traffic_out router_in router_out elb_cps elb_active_connections"`
+	// Aggregation 複数リソースの値の集約方法、省略時はavg
+	Aggregation string `yaml:"aggregation" validate:"omitempty,oneof=avg max sum"`
+	// Period 各リソースの値として平均をとる期間(分)、省略時は10分
+	Period int `yaml:"period" validate:"omitempty,min=1"`
+
+	Source string `yaml:"source" validate:"omitempty,printascii,max=1024"`
+	// ResourceName 対象リソースを表すCoreのリソース定義の名前、Coreへのリクエストにも利用する
+	//
+	// Server/ServerGroupの場合はサーバの値を、加えて親リソースがELBの場合はELBの値を参照できる。
+	// 省略時はCoreのリソース定義が1つだけの場合にそのリソース定義を対象とする
+	ResourceName     string `yaml:"resource_name" validate:"omitempty,printascii,max=1024"`
+	DesiredStateName string `yaml:"desired_state_name" validate:"omitempty,printascii,max=1024"`
+
+	// Target 目標値
+	Target *float64 `yaml:"target"`
+	// Tolerance Targetからの許容範囲(比率)、省略時は0.1(±10%)
+	Tolerance *float64 `yaml:"tolerance" validate:"omitempty,gte=0"`
+	// Upper 上限値、省略時はTarget*(1+Tolerance)
+	Upper *float64 `yaml:"upper"`
+	// Lower 下限値、省略時はTarget*(1-Tolerance)
+	Lower *float64 `yaml:"lower"`
+	// SendKeep 値がUpper/Lowerの範囲内の場合にKeepをリクエストするか
+	SendKeep bool `yaml:"send_keep"`
+}
+
+// LoadConfigFromPath 指定のパスからConfigをロードする
+func LoadConfigFromPath(path string) (*Config, error) {
+	data, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+	c := &Config{}
+	if err := yaml.UnmarshalWithOptions(data, c, yaml.Strict()); err != nil {
+		return nil, err
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Validate 設定値を検証する
+func (c *Config) Validate() error {
+	if err := validate.Struct(c); err != nil {
+		return err
+	}
+	for _, r := range c.Rules {
+		if r.Target == nil && r.Upper == nil && r.Lower == nil {
+			return fmt.Errorf("rule %q: target or upper/lower is required", r.Name)
+		}
+		upper, lower := r.thresholds()
+		if upper != nil && lower != nil && *lower > *upper {
+			return fmt.Errorf("rule %q: lower must be less than or equal to upper", r.Name)
+		}
+	}
+	return nil
+}
+
+// SakuraCloudConfig APIキーなどの設定を返す、省略されていた場合はcoreConfigの値を返す
+func (c *Config) SakuraCloudConfig(coreConfig *core.SakuraCloud) *core.SakuraCloud {
+	if c.SakuraCloud == nil {
+		c.SakuraCloud = coreConfig
+	}
+	return c.SakuraCloud
+}
+
+func (c *Config) interval() time.Duration {
+	if c.Interval > 0 {
+		return time.Duration(c.Interval) * time.Second
+	}
+	return defaultInterval * time.Second
+}
+
+// resourceType メトリクスを参照するリソースの種別を返す
+func (r *Rule) resourceType() core.ResourceTypes {
+	switch r.Metric {
+	case MetricRouterIn, MetricRouterOut:
+		return core.ResourceTypeRouter
+	case MetricELBCPS, MetricELBActiveConnections:
+		return core.ResourceTypeELB
+	default:
+		return core.ResourceTypeServer
+	}
+}
+
+func (r *Rule) period() time.Duration {
+	if r.Period > 0 {
+		return time.Duration(r.Period) * time.Minute
+	}
+	return defaultPeriod * time.Minute
+}
+
+func (r *Rule) aggregation() string {
+	if r.Aggregation != "" {
+		return r.Aggregation
+	}
+	return AggregationAvg
+}
+
+// thresholds 上限値と下限値を返す
+func (r *Rule) thresholds() (upper, lower *float64) {
+	return inputs.Thresholds(r.Target, r.Tolerance, r.Upper, r.Lower)
+}
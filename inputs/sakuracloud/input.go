@@ -0,0 +1,285 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/sacloud/autoscaler/core"
+	"github.com/sacloud/autoscaler/defaults"
+	"github.com/sacloud/autoscaler/inputs"
+	"github.com/sacloud/autoscaler/version"
+	"github.com/sacloud/iaas-api-go"
+)
+
+// errNoValues 対象リソースやアクティビティモニタの値が見つからなかった
+var errNoValues = errors.New("no values found")
+
+// Input さくらのクラウドのアクティビティモニタを定期的に参照しCoreへのリクエストを行うInput
+//
+// Webhookは受け付けずinputs.Pollerとして動作する
+type Input struct {
+	dest       string
+	configPath string
+	logger     *slog.Logger
+
+	apiClient iaas.APICaller
+	config    *Config
+	resources core.ResourceDefinitions // 対象リソースを特定するためのCoreのリソース定義
+	now       func() time.Time
+}
+
+func NewInput(dest, configPath string, logger *slog.Logger, apiClient iaas.APICaller, config *Config, resources core.ResourceDefinitions) *Input {
+	return &Input{
+		dest:       dest,
+		configPath: configPath,
+		logger:     logger,
+
+		apiClient: apiClient,
+		config:    config,
+		resources: resources,
+		now:       time.Now,
+	}
+}
+
+func (in *Input) Name() string {
+	return "sakuracloud"
+}
+
+func (in *Input) Version() string {
+	return version.FullVersion()
+}
+
+func (in *Input) Destination() string {
+	return in.dest
+}
+
+// ListenAddress Webhookを受け付けないため空文字を返す
+func (in *Input) ListenAddress() string {
+	return ""
+}
+
+func (in *Input) ConfigPath() string {
+	return in.configPath
+}
+
+func (in *Input) GetLogger() *slog.Logger {
+	return in.logger
+}
+
+// ShouldAccept Webhookは受け付けないため常にfalseを返す
+func (in *Input) ShouldAccept(*http.Request) (bool, error) {
+	return false, nil
+}
+
+// Poll inputs.Pollerの実装
+func (in *Input) Poll(ctx context.Context, sender inputs.Sender) error {
+	ticker := time.NewTicker(in.config.interval())
+	defer ticker.Stop()
+
+	in.logger.Info("polling started", slog.Duration("interval", in.config.interval()))
+	for {
+		in.evaluateAll(ctx, sender)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (in *Input) evaluateAll(ctx context.Context, sender inputs.Sender) {
+	for _, rule := range in.config.Rules {
+		if err := in.evaluate(ctx, sender, rule); err != nil {
+			in.logger.Error("evaluating rule failed", slog.String("rule", rule.Name), slog.Any("error", err))
+		}
+	}
+}
+
+func (in *Input) evaluate(ctx context.Context, sender inputs.Sender, rule *Rule) error {
+	value, err := in.collect(ctx, rule)
+	if err != nil {
+		return err
+	}
+
+	requestType := requestTypeFor(rule, value)
+	if requestType == "" {
+		in.logger.Debug("value is within the thresholds", slog.String("rule", rule.Name), slog.Float64("value", value))
+		return nil
+	}
+
+	scalingReq := &inputs.ScalingRequest{
+		Source:           rule.Source,
+		ResourceName:     rule.ResourceName,
+		RequestType:      requestType,
+		DesiredStateName: rule.DesiredStateName,
+	}
+	if scalingReq.Source == "" {
+		scalingReq.Source = defaults.SourceName
+	}
+	if scalingReq.ResourceName == "" {
+		scalingReq.ResourceName = defaults.ResourceName
+	}
+	if scalingReq.DesiredStateName == "" {
+		scalingReq.DesiredStateName = defaults.DesiredStateName
+	}
+
+	in.logger.Info("sending request to the Core server",
+		slog.String("rule", rule.Name),
+		slog.String("request-type", requestType),
+		slog.Float64("value", value),
+	)
+	res, err := sender.Send(ctx, scalingReq)
+	if err != nil {
+		var dropped *inputs.DroppedError
+		if errors.As(err, &dropped) {
+			in.logger.Info("request dropped", slog.String("rule", rule.Name), slog.String("reason", dropped.Reason))
+			return nil
+		}
+		return err
+	}
+	in.logger.Info("request handled",
+		slog.String("rule", rule.Name),
+		slog.String("status", res.Status.String()),
+		slog.String("job-id", res.ScalingJobId),
+		slog.String("job-message", res.Message),
+	)
+	return nil
+}
+
+// requestTypeFor 値からリクエスト種別を判定する、リクエスト不要な場合は空文字を返す
+func requestTypeFor(rule *Rule, value float64) string {
+	upper, lower := rule.thresholds()
+	switch {
+	case upper != nil && value > *upper:
+		return "up"
+	case lower != nil && value < *lower:
+		return "down"
+	case rule.SendKeep:
+		return "keep"
+	}
+	return ""
+}
+
+// collect 対象リソースごとの値を取得し集約した値を返す
+func (in *Input) collect(ctx context.Context, rule *Rule) (float64, error) {
+	targets, err := in.resources.MonitoringTargets(ctx, in.apiClient, rule.ResourceName)
+	if err != nil {
+		return 0, err
+	}
+
+	now := in.now()
+	cond := &iaas.MonitorCondition{Start: now.Add(-rule.period()), End: now}
+
+	var values []float64
+	for _, target := range targets {
+		if target.Type != rule.resourceType() {
+			continue
+		}
+		points, err := in.monitor(ctx, rule, target, cond)
+		if err != nil {
+			return 0, err
+		}
+		if len(points) > 0 {
+			values = append(values, aggregate(AggregationAvg, points))
+		}
+	}
+	if len(values) == 0 {
+		return 0, errNoValues
+	}
+	return aggregate(rule.aggregation(), values), nil
+}
+
+// monitor 対象リソースのアクティビティモニタの値を取得する
+func (in *Input) monitor(ctx context.Context, rule *Rule, target *core.MonitoringTarget, cond *iaas.MonitorCondition) ([]float64, error) {
+	var points []float64
+	switch rule.Metric {
+	case MetricServerCPU:
+		activity, err := iaas.NewServerOp(in.apiClient).MonitorCPU(ctx, target.Zone, target.ID, cond)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range activity.Values {
+			points = append(points, v.CPUTime)
+		}
+	case MetricServerTrafficIn, MetricServerTrafficOut:
+		if target.InterfaceID.IsEmpty() {
+			return nil, nil
+		}
+		activity, err := iaas.NewInterfaceOp(in.apiClient).Monitor(ctx, target.Zone, target.InterfaceID, cond)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range activity.Values {
+			if rule.Metric == MetricServerTrafficIn {
+				points = append(points, v.Receive)
+			} else {
+				points = append(points, v.Send)
+			}
+		}
+	case MetricRouterIn, MetricRouterOut:
+		activity, err := iaas.NewInternetOp(in.apiClient).Monitor(ctx, target.Zone, target.ID, cond)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range activity.Values {
+			if rule.Metric == MetricRouterIn {
+				points = append(points, v.In)
+			} else {
+				points = append(points, v.Out)
+			}
+		}
+	case MetricELBCPS, MetricELBActiveConnections:
+		activity, err := iaas.NewProxyLBOp(in.apiClient).MonitorConnection(ctx, target.ID, cond)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range activity.Values {
+			if rule.Metric == MetricELBCPS {
+				points = append(points, v.ConnectionsPerSec)
+			} else {
+				points = append(points, v.ActiveConnections)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported metric: %s", rule.Metric)
+	}
+	return points, nil
+}
+
+// aggregate valuesを集約する、valuesは空でないこと
+func aggregate(aggregation string, values []float64) float64 {
+	var result float64
+	for i, v := range values {
+		switch aggregation {
+		case AggregationMax:
+			if i == 0 || v > result {
+				result = v
+			}
+		default:
+			result += v
+		}
+	}
+	if aggregation == AggregationAvg {
+		result /= float64(len(values))
+	}
+	return result
+}
@@ -0,0 +1,224 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sacloud/autoscaler/core"
+	"github.com/sacloud/autoscaler/inputs"
+	"github.com/sacloud/autoscaler/request"
+	"github.com/sacloud/autoscaler/test"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSender struct {
+	received []*inputs.ScalingRequest
+}
+
+func (s *fakeSender) Send(_ context.Context, req *inputs.ScalingRequest) (*request.ScalingResponse, error) {
+	s.received = append(s.received, req)
+	return &request.ScalingResponse{ScalingJobId: "1", Status: request.ScalingJobStatus_JOB_ACCEPTED}, nil
+}
+
+func TestInput_evaluate(t *testing.T) {
+	_, cleanup1 := test.AddTestServer(t, "test-sakuracloud-input-web-001")
+	defer cleanup1()
+	_, cleanup2 := test.AddTestServer(t, "test-sakuracloud-input-web-002")
+	defer cleanup2()
+	cleanup3 := test.AddTestELB(t, "test-sakuracloud-input-elb")
+	defer cleanup3()
+
+	resources := core.ResourceDefinitions{
+		&core.ResourceDefServer{
+			ResourceDefBase: &core.ResourceDefBase{TypeName: "Server", DefName: "web"},
+			Selector: &core.MultiZoneSelector{
+				ResourceSelector: &core.ResourceSelector{Names: []string{"test-sakuracloud-input-web"}},
+				Zones:            []string{test.Zone},
+			},
+		},
+		&core.ResourceDefELB{
+			ResourceDefBase: &core.ResourceDefBase{TypeName: "EnhancedLoadBalancer", DefName: "elb"},
+			Selector:        &core.ResourceSelector{Names: []string{"test-sakuracloud-input-elb"}},
+		},
+		&core.ResourceDefServer{
+			ResourceDefBase: &core.ResourceDefBase{TypeName: "Server", DefName: "not-exists"},
+			Selector: &core.MultiZoneSelector{
+				ResourceSelector: &core.ResourceSelector{Names: []string{"not-exists"}},
+				Zones:            []string{test.Zone},
+			},
+		},
+	}
+
+	// FakeModeのアクティビティモニタは0〜1000の範囲(CPU-TIMEはコア数*1000未満)のランダムな値を返す
+	tests := []struct {
+		name    string
+		rule    *Rule
+		want    []*inputs.ScalingRequest
+		wantErr bool
+	}{
+		{
+			name: "server cpu over upper",
+			rule: &Rule{
+				Name:         "cpu",
+				Metric:       MetricServerCPU,
+				Upper:        ptr(-1),
+				ResourceName: "web",
+			},
+			want: []*inputs.ScalingRequest{
+				{Source: "default", ResourceName: "web", RequestType: "up", DesiredStateName: "default"},
+			},
+		},
+		{
+			name: "elb cps under lower",
+			rule: &Rule{
+				Name:         "cps",
+				Metric:       MetricELBCPS,
+				Aggregation:  AggregationMax,
+				Lower:        ptr(1001),
+				ResourceName: "elb",
+			},
+			want: []*inputs.ScalingRequest{
+				{Source: "default", ResourceName: "elb", RequestType: "down", DesiredStateName: "default"},
+			},
+		},
+		{
+			name: "within thresholds",
+			rule: &Rule{
+				Name:         "cps",
+				Metric:       MetricELBActiveConnections,
+				Upper:        ptr(1001),
+				Lower:        ptr(-1),
+				ResourceName: "elb",
+			},
+			want: nil,
+		},
+		{
+			name: "within thresholds with send_keep",
+			rule: &Rule{
+				Name:         "cps",
+				Metric:       MetricELBActiveConnections,
+				Upper:        ptr(1001),
+				Lower:        ptr(-1),
+				SendKeep:     true,
+				ResourceName: "elb",
+			},
+			want: []*inputs.ScalingRequest{
+				{Source: "default", ResourceName: "elb", RequestType: "keep", DesiredStateName: "default"},
+			},
+		},
+		{
+			name: "servers without NIC",
+			rule: &Rule{
+				Name:         "traffic",
+				Metric:       MetricServerTrafficIn,
+				Upper:        ptr(-1),
+				ResourceName: "web",
+			},
+			wantErr: true,
+		},
+		{
+			name: "resources not found",
+			rule: &Rule{
+				Name:         "cpu",
+				Metric:       MetricServerCPU,
+				Upper:        ptr(-1),
+				ResourceName: "not-exists",
+			},
+			wantErr: true,
+		},
+		{
+			name: "metric not supported by the resource",
+			rule: &Rule{
+				Name:         "cps",
+				Metric:       MetricELBCPS,
+				Upper:        ptr(-1),
+				ResourceName: "web",
+			},
+			wantErr: true,
+		},
+		{
+			name: "resource definition not found",
+			rule: &Rule{
+				Name:         "cpu",
+				Metric:       MetricServerCPU,
+				Upper:        ptr(-1),
+				ResourceName: "unknown",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := NewInput("", "", test.Logger, test.APIClient, &Config{Rules: []*Rule{tt.rule}}, resources)
+			sender := &fakeSender{}
+
+			err := in.evaluate(context.Background(), sender, tt.rule)
+			require.Equal(t, tt.wantErr, err != nil, err)
+			require.Equal(t, tt.want, sender.received)
+		})
+	}
+}
+
+func Test_aggregate(t *testing.T) {
+	values := []float64{1, 2, 6}
+	require.Equal(t, float64(3), aggregate(AggregationAvg, values))
+	require.Equal(t, float64(6), aggregate(AggregationMax, values))
+	require.Equal(t, float64(9), aggregate(AggregationSum, values))
+}
+
+func TestLoadConfigFromPath(t *testing.T) {
+	c, err := LoadConfigFromPath("test/config.yaml")
+	require.NoError(t, err)
+	require.Len(t, c.Rules, 2)
+
+	upper, lower := c.Rules[0].thresholds()
+	require.InDelta(t, 440, *upper, 0.001)
+	require.InDelta(t, 360, *lower, 0.001)
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    *Rule
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			rule: &Rule{Name: "cpu", Metric: MetricServerCPU, ResourceName: "web", Target: ptr(400)},
+		},
+		{
+			name:    "without thresholds",
+			rule:    &Rule{Name: "cps", Metric: MetricELBCPS, ResourceName: "elb"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid metric",
+			rule:    &Rule{Name: "cps", Metric: "memory", ResourceName: "elb", Upper: ptr(100)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&Config{Rules: []*Rule{tt.rule}}).Validate()
+			require.Equal(t, tt.wantErr, err != nil, err)
+		})
+	}
+}
+
+func ptr(v float64) *float64 {
+	return &v
+}
@@ -0,0 +1,169 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simplemonitor
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/sacloud/autoscaler/inputs"
+)
+
+// MappingConfig シンプル監視の監視対象からリクエストパラメータへのマッピング設定
+type MappingConfig struct {
+	// Rules マッピングルールのリスト、上から順に評価され最初にマッチしたものが利用される
+	Rules []*MappingRule `yaml:"rules"`
+	// Payload 通知のペイロードの解釈方法、省略時は既定値を利用する
+	Payload *PayloadConfig `yaml:"payload"`
+}
+
+// MappingRule 監視対象とリソース名/DesiredStateNameの対応
+type MappingRule struct {
+	// Target マッチさせる監視対象(IPアドレスまたはFQDN、inputs.MatchPatternのパターン)、省略時は全てにマッチする
+	Target string `yaml:"target"`
+
+	// ResourceName マッチした場合に利用するリソース名、省略時はクエリストリングの値を利用する
+	ResourceName string `yaml:"resource_name"`
+	// DesiredStateName マッチした場合に利用するDesiredStateName、省略時はクエリストリングの値を利用する
+	DesiredStateName string `yaml:"desired_state_name"`
+}
+
+// PayloadConfig シンプル監視の通知(Slack形式)から監視対象やヘルス状態を取り出す方法の設定
+//
+// シンプル監視の通知内容は変更される可能性があるため、既定値と異なる場合はここで上書きする
+type PayloadConfig struct {
+	// IDField シンプル監視のリソースIDが格納されたfieldのタイトル、省略時は"リソースID"
+	IDField string `yaml:"id_field"`
+	// TargetField 監視対象が格納されたfieldのタイトル、省略時は"監視対象"
+	TargetField string `yaml:"target_field"`
+	// StatusField ヘルス状態(UP/DOWN)が格納されたfieldのタイトル、省略時は"ステータス"
+	//
+	// 該当するfieldが存在しない場合はcolorからヘルス状態を判定する
+	StatusField string `yaml:"status_field"`
+	// UpColors ヘルス状態をupとみなすcolorのリスト、省略時は"good"
+	UpColors []string `yaml:"up_colors"`
+	// DownColors ヘルス状態をdownとみなすcolorのリスト、省略時は"danger"
+	DownColors []string `yaml:"down_colors"`
+}
+
+const (
+	defaultIDField     = "リソースID"
+	defaultTargetField = "監視対象"
+	defaultStatusField = "ステータス"
+)
+
+var (
+	defaultUpColors   = []string{"good"}
+	defaultDownColors = []string{"danger"}
+)
+
+func (p *PayloadConfig) idField() string {
+	if p == nil || p.IDField == "" {
+		return defaultIDField
+	}
+	return p.IDField
+}
+
+func (p *PayloadConfig) targetField() string {
+	if p == nil || p.TargetField == "" {
+		return defaultTargetField
+	}
+	return p.TargetField
+}
+
+func (p *PayloadConfig) statusField() string {
+	if p == nil || p.StatusField == "" {
+		return defaultStatusField
+	}
+	return p.StatusField
+}
+
+// healthByColor colorに対応するヘルス状態を返す、該当しない場合は空文字を返す
+func (p *PayloadConfig) healthByColor(color string) string {
+	upColors, downColors := defaultUpColors, defaultDownColors
+	if p != nil && len(p.UpColors) > 0 {
+		upColors = p.UpColors
+	}
+	if p != nil && len(p.DownColors) > 0 {
+		downColors = p.DownColors
+	}
+
+	for _, c := range upColors {
+		if strings.EqualFold(c, color) {
+			return HealthUp
+		}
+	}
+	for _, c := range downColors {
+		if strings.EqualFold(c, color) {
+			return HealthDown
+		}
+	}
+	return ""
+}
+
+// LoadMappingConfigFromPath 指定のパスからMappingConfigをロードする
+func LoadMappingConfigFromPath(filePath string) (*MappingConfig, error) {
+	if filePath == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(filePath) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+	c := &MappingConfig{}
+	if err := yaml.UnmarshalWithOptions(data, c, yaml.Strict()); err != nil {
+		return nil, err
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Validate パターンの書式を検証する
+func (c *MappingConfig) Validate() error {
+	for i, rule := range c.Rules {
+		if err := inputs.ValidatePattern(rule.Target); err != nil {
+			return fmt.Errorf("rules[%d]: %s", i, err)
+		}
+	}
+	return nil
+}
+
+func (c *MappingConfig) payload() *PayloadConfig {
+	if c == nil {
+		return nil
+	}
+	return c.Payload
+}
+
+// Find 監視対象にマッチするルールを返す
+func (c *MappingConfig) Find(target string) *MappingRule {
+	if c == nil {
+		return nil
+	}
+	for _, rule := range c.Rules {
+		if rule.match(target) {
+			return rule
+		}
+	}
+	return nil
+}
+
+func (r *MappingRule) match(target string) bool {
+	return inputs.MatchPattern(r.Target, target)
+}
@@ -0,0 +1,348 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simplemonitor
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sacloud/autoscaler/inputs"
+	"github.com/sacloud/autoscaler/version"
+)
+
+const (
+	HealthUp   = "up"
+	HealthDown = "down"
+
+	// RequestTypeIgnore ヘルス状態の変化をCoreへ送信しない場合に指定する
+	RequestTypeIgnore = "ignore"
+
+	// LabelTarget Coreへのリクエストに付与する監視対象のラベル名
+	LabelTarget = "simplemonitor.target"
+	// LabelHealth Coreへのリクエストに付与するヘルス状態のラベル名
+	LabelHealth = "simplemonitor.health"
+)
+
+type Input struct {
+	dest       string
+	addr       string
+	configPath string
+	logger     *slog.Logger
+
+	downRequestType string
+	upRequestType   string
+	mapping         *MappingConfig
+
+	mu       sync.Mutex
+	states   map[string]*healthState // key: 監視対象
+	pendings map[string]*healthState // key: 監視対象、Coreへの送信結果待ちの状態
+}
+
+// healthState 監視対象ごとに最後に受け付けたヘルス状態
+type healthState struct {
+	health    string
+	changedAt time.Time
+}
+
+// NewInput simplemonitor Inputを作成する
+//
+// downRequestType/upRequestTypeには監視対象がダウン/アップに変化した際にCoreへ送信するリクエスト種別(up/down/keep/ignore)を指定する
+func NewInput(dest, addr, configPath string, logger *slog.Logger, downRequestType, upRequestType string, mapping *MappingConfig) *Input {
+	return &Input{
+		dest:       dest,
+		addr:       addr,
+		configPath: configPath,
+		logger:     logger,
+
+		downRequestType: downRequestType,
+		upRequestType:   upRequestType,
+		mapping:         mapping,
+		states:          make(map[string]*healthState),
+		pendings:        make(map[string]*healthState),
+	}
+}
+
+func (in *Input) Name() string {
+	return "simplemonitor"
+}
+
+func (in *Input) Version() string {
+	return version.FullVersion()
+}
+
+func (in *Input) Destination() string {
+	return in.dest
+}
+
+func (in *Input) ListenAddress() string {
+	return in.addr
+}
+
+func (in *Input) ConfigPath() string {
+	return in.configPath
+}
+
+func (in *Input) GetLogger() *slog.Logger {
+	return in.logger
+}
+
+// ShouldAccept inputs.Inputの実装
+//
+// BuildScalingRequestを実装しているためinputs.serverからは呼ばれない。
+// ヘルス状態は変更せず、シンプル監視の通知として解釈できるかのみを判定する
+func (in *Input) ShouldAccept(req *http.Request) (bool, error) {
+	received, err := in.parseBody(req)
+	if err != nil {
+		return false, err
+	}
+	return received != nil, nil
+}
+
+// BuildScalingRequest inputs.ScalingRequestBuilderの実装
+//
+// 監視対象のヘルス状態が変化した通知のみを受け付け、変化後の状態に応じたリクエスト種別でリクエストを組み立てる。
+// 同じ状態の再通知や、受け付け済みの変化より前の変化の通知は無視する。
+// ヘルス状態の変化はCoreへの送信に成功した時点(ObserveDelivery)で受け付け済みとなる
+func (in *Input) BuildScalingRequest(req *http.Request, base *inputs.ScalingRequest) (*inputs.ScalingRequest, error) {
+	received, err := in.parseBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if received == nil {
+		return nil, nil
+	}
+
+	if !in.changed(received) {
+		in.logger.Info("health status not changed",
+			slog.String("target", received.Target),
+			slog.String("health", received.health()),
+		)
+		return nil, nil
+	}
+
+	requestType := in.upRequestType
+	if received.health() == HealthDown {
+		requestType = in.downRequestType
+	}
+	if requestType == RequestTypeIgnore || requestType == "" {
+		// Coreへ送信しないためこの時点で状態を確定させる
+		in.commit(received.Target, received.state())
+		return nil, nil
+	}
+
+	scalingReq := *base
+	scalingReq.RequestType = requestType
+	if rule := in.mapping.Find(received.Target); rule != nil {
+		if rule.ResourceName != "" {
+			scalingReq.ResourceName = rule.ResourceName
+		}
+		if rule.DesiredStateName != "" {
+			scalingReq.DesiredStateName = rule.DesiredStateName
+		}
+	}
+	scalingReq.Labels = map[string]string{
+		LabelTarget: received.Target,
+		LabelHealth: received.health(),
+	}
+	for k, v := range base.Labels {
+		scalingReq.Labels[k] = v
+	}
+
+	in.mu.Lock()
+	in.pendings[received.Target] = received.state()
+	in.mu.Unlock()
+
+	in.logger.Info("health status changed",
+		slog.String("simple-monitor-id", received.ID),
+		slog.String("target", received.Target),
+		slog.String("health", received.health()),
+		slog.Time("notified-at", received.NotifiedAt),
+	)
+	return &scalingReq, nil
+}
+
+// ObserveDelivery inputs.DeliveryObserverの実装
+//
+// Coreへの送信に成功した場合のみヘルス状態の変化を確定させる。
+// 失敗した場合は状態を確定させないため、シンプル監視からの再通知で再度リクエストを行う
+func (in *Input) ObserveDelivery(scalingReq *inputs.ScalingRequest, err error) {
+	target, ok := scalingReq.Labels[LabelTarget]
+	if !ok {
+		return
+	}
+
+	in.mu.Lock()
+	pending, ok := in.pendings[target]
+	if !ok || pending.health != scalingReq.Labels[LabelHealth] {
+		in.mu.Unlock()
+		return
+	}
+	delete(in.pendings, target)
+	in.mu.Unlock()
+
+	if err != nil {
+		in.logger.Warn("health status change was not delivered",
+			slog.String("target", target),
+			slog.String("health", pending.health),
+			slog.Any("error", err),
+		)
+		return
+	}
+	in.commit(target, pending)
+}
+
+// changed 受信した通知が確定済みの状態からのヘルス状態の変化を表す場合にtrueを返す
+func (in *Input) changed(received *notification) bool {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	return in.isNewer(received.Target, received.state())
+}
+
+// commit 監視対象のヘルス状態の変化を確定させる
+func (in *Input) commit(target string, state *healthState) {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	if in.isNewer(target, state) {
+		in.states[target] = state
+	}
+}
+
+// isNewer stateが確定済みの状態から変化しており、かつ確定済みの変化より前の変化でない場合にtrueを返す
+//
+// 呼び出し側でmuをロックしておく必要がある
+func (in *Input) isNewer(target string, state *healthState) bool {
+	prev, ok := in.states[target]
+	if !ok {
+		return true
+	}
+	if prev.health == state.health {
+		return false
+	}
+	if !state.changedAt.IsZero() && state.changedAt.Before(prev.changedAt) {
+		return false
+	}
+	return true
+}
+
+func (in *Input) parseBody(req *http.Request) (*notification, error) {
+	var message slackMessage
+	if ok, err := inputs.DecodeJSONBody(req, &message, http.MethodPost); !ok || err != nil {
+		return nil, err
+	}
+	received, err := message.notification(in.mapping.payload())
+	if err != nil {
+		return nil, err
+	}
+	if err := received.validate(); err != nil {
+		return nil, err
+	}
+	return received, nil
+}
+
+// slackMessage シンプル監視の通知のペイロード
+//
+// シンプル監視はWebhookの通知先としてSlackのIncoming Webhooks形式のみをサポートしているため、
+// SlackのIncoming Webhooks形式のメッセージとして受け取る。
+// 監視対象などの値はattachmentsのfieldsに、ヘルス状態はattachmentsのcolorに格納されている前提で解釈する。
+// fieldsのタイトルやcolorの値はPayloadConfigで変更できる
+type slackMessage struct {
+	Text        string             `json:"text"`
+	Attachments []*slackAttachment `json:"attachments"`
+}
+
+type slackAttachment struct {
+	Color  string        `json:"color"`
+	Title  string        `json:"title"`
+	Text   string        `json:"text"`
+	Fields []*slackField `json:"fields"`
+	// Ts 通知日時(UNIXエポック秒)
+	Ts json.Number `json:"ts"`
+}
+
+type slackField struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+// notification メッセージから監視対象とヘルス状態を取り出す
+func (m *slackMessage) notification(payload *PayloadConfig) (*notification, error) {
+	if len(m.Attachments) == 0 {
+		return nil, fmt.Errorf("attachments: required")
+	}
+	attachment := m.Attachments[0]
+
+	n := &notification{
+		ID:     attachment.field(payload.idField()),
+		Target: attachment.field(payload.targetField()),
+		Health: attachment.field(payload.statusField()),
+	}
+	if n.Health == "" {
+		n.Health = payload.healthByColor(attachment.Color)
+	}
+	if attachment.Ts != "" {
+		ts, err := attachment.Ts.Float64()
+		if err != nil {
+			return nil, fmt.Errorf("ts: invalid value %q", attachment.Ts)
+		}
+		n.NotifiedAt = time.UnixMilli(int64(ts * 1000))
+	}
+	return n, nil
+}
+
+func (a *slackAttachment) field(title string) string {
+	for _, f := range a.Fields {
+		if f.Title == title {
+			return strings.TrimSpace(f.Value)
+		}
+	}
+	return ""
+}
+
+// notification シンプル監視の通知から取り出した監視対象のヘルス状態
+type notification struct {
+	// ID シンプル監視のリソースID
+	ID string
+	// Target 監視対象のIPアドレスまたはFQDN
+	Target string
+	// Health ヘルス状態 up/down
+	Health string
+	// NotifiedAt 通知日時、ヘルス状態の変化の前後関係の判定に利用する
+	NotifiedAt time.Time
+}
+
+func (n *notification) health() string {
+	return strings.ToLower(n.Health)
+}
+
+func (n *notification) state() *healthState {
+	return &healthState{health: n.health(), changedAt: n.NotifiedAt}
+}
+
+func (n *notification) validate() error {
+	if n.Target == "" {
+		return fmt.Errorf("target: required")
+	}
+	switch n.health() {
+	case HealthUp, HealthDown:
+		return nil
+	default:
+		return fmt.Errorf("health: invalid value %q", n.Health)
+	}
+}
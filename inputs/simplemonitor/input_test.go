@@ -0,0 +1,272 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simplemonitor
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sacloud/autoscaler/inputs"
+	"github.com/sacloud/autoscaler/test"
+	"github.com/stretchr/testify/require"
+)
+
+// webhookBody/webhookUpBody PayloadConfigの既定値に沿って組み立てたシンプル監視の通知のペイロード
+var (
+	//go:embed test/webhook_down.json
+	webhookBody []byte
+	//go:embed test/webhook_up.json
+	webhookUpBody []byte
+)
+
+// slackBody シンプル監視の通知と同じ形式のペイロードを組み立てる、statusが空の場合はcolorのみでヘルス状態を表す
+func slackBody(color, target, status string, ts int64) string {
+	fields := []map[string]interface{}{
+		{"title": "監視対象", "value": target, "short": true},
+	}
+	if status != "" {
+		fields = append(fields, map[string]interface{}{"title": "ステータス", "value": status, "short": true})
+	}
+	attachment := map[string]interface{}{"color": color, "fields": fields}
+	if ts > 0 {
+		attachment["ts"] = ts
+	}
+	data, _ := json.Marshal(map[string]interface{}{"attachments": []interface{}{attachment}})
+	return string(data)
+}
+
+func TestInput_BuildScalingRequest(t *testing.T) {
+	base := &inputs.ScalingRequest{
+		Source:           "default",
+		ResourceName:     "default",
+		RequestType:      "up",
+		DesiredStateName: "default",
+	}
+	withRequestType := func(requestType, target, health string) *inputs.ScalingRequest {
+		r := *base
+		r.RequestType = requestType
+		r.Labels = map[string]string{LabelTarget: target, LabelHealth: health}
+		return &r
+	}
+	mapping, err := LoadMappingConfigFromPath("test/mapping.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type call struct {
+		method      string
+		body        string
+		deliveryErr error
+		want        *inputs.ScalingRequest
+		wantErr     bool
+	}
+	tests := []struct {
+		name            string
+		downRequestType string
+		upRequestType   string
+		mapping         *MappingConfig
+		calls           []call
+	}{
+		{
+			name:            "down to up",
+			downRequestType: "up",
+			upRequestType:   "keep",
+			calls: []call{
+				{method: http.MethodPost, body: string(webhookBody), want: withRequestType("up", "192.0.2.11", "down")},
+				{method: http.MethodPost, body: string(webhookUpBody), want: withRequestType("keep", "192.0.2.11", "up")},
+			},
+		},
+		{
+			name:            "renotification is ignored",
+			downRequestType: "up",
+			upRequestType:   "keep",
+			calls: []call{
+				{method: http.MethodPost, body: string(webhookBody), want: withRequestType("up", "192.0.2.11", "down")},
+				{method: http.MethodPost, body: string(webhookBody), want: nil},
+			},
+		},
+		{
+			name:            "not delivered change is requested again",
+			downRequestType: "up",
+			upRequestType:   "keep",
+			calls: []call{
+				{method: http.MethodPost, body: string(webhookBody), deliveryErr: errors.New("connection refused"), want: withRequestType("up", "192.0.2.11", "down")},
+				{method: http.MethodPost, body: string(webhookBody), want: withRequestType("up", "192.0.2.11", "down")},
+				{method: http.MethodPost, body: string(webhookBody), want: nil},
+			},
+		},
+		{
+			name:            "outdated notification is ignored",
+			downRequestType: "up",
+			upRequestType:   "keep",
+			calls: []call{
+				{method: http.MethodPost, body: string(webhookBody), want: withRequestType("up", "192.0.2.11", "down")},
+				{method: http.MethodPost, body: slackBody("good", "192.0.2.11", "UP", 1735689600), want: nil},
+			},
+		},
+		{
+			name:            "targets are tracked separately",
+			downRequestType: "up",
+			upRequestType:   "keep",
+			calls: []call{
+				{method: http.MethodPost, body: string(webhookBody), want: withRequestType("up", "192.0.2.11", "down")},
+				{method: http.MethodPost, body: slackBody("danger", "192.0.2.12", "", 0), want: withRequestType("up", "192.0.2.12", "down")},
+			},
+		},
+		{
+			name:            "up is ignored",
+			downRequestType: "up",
+			upRequestType:   "ignore",
+			calls: []call{
+				{method: http.MethodPost, body: slackBody("good", "192.0.2.11", "", 0), want: nil},
+			},
+		},
+		{
+			name:            "mapped by target",
+			downRequestType: "up",
+			upRequestType:   "keep",
+			mapping:         mapping,
+			calls: []call{
+				{
+					method: http.MethodPost,
+					body:   string(webhookBody),
+					want: &inputs.ScalingRequest{
+						Source:           "default",
+						ResourceName:     "web",
+						RequestType:      "up",
+						DesiredStateName: "large",
+						Labels:           map[string]string{LabelTarget: "192.0.2.11", LabelHealth: "down"},
+					},
+				},
+				{
+					method: http.MethodPost,
+					body:   slackBody("danger", "www.example.com", "DOWN", 0),
+					want: &inputs.ScalingRequest{
+						Source:           "default",
+						ResourceName:     "elb",
+						RequestType:      "up",
+						DesiredStateName: "default",
+						Labels:           map[string]string{LabelTarget: "www.example.com", LabelHealth: "down"},
+					},
+				},
+			},
+		},
+		{
+			name:            "not POST",
+			downRequestType: "up",
+			upRequestType:   "keep",
+			calls: []call{
+				{method: http.MethodGet, body: string(webhookBody), want: nil},
+			},
+		},
+		{
+			name:            "invalid payload",
+			downRequestType: "up",
+			upRequestType:   "keep",
+			calls: []call{
+				{method: http.MethodPost, body: `{`, wantErr: true},
+				{method: http.MethodPost, body: `{"text":"test"}`, wantErr: true},
+				{method: http.MethodPost, body: slackBody("danger", "", "DOWN", 0), wantErr: true},
+				{method: http.MethodPost, body: slackBody("warning", "192.0.2.11", "", 0), wantErr: true},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := NewInput("", "", "", test.Logger, tt.downRequestType, tt.upRequestType, tt.mapping)
+			for i, c := range tt.calls {
+				req := httptest.NewRequest(c.method, "/up", bytes.NewReader([]byte(c.body)))
+
+				got, err := in.BuildScalingRequest(req, base)
+				require.Equal(t, c.wantErr, err != nil, "calls[%d]: %s", i, err)
+				require.Equal(t, c.want, got, "calls[%d]", i)
+				if got != nil {
+					in.ObserveDelivery(got, c.deliveryErr)
+				}
+			}
+		})
+	}
+}
+
+func TestMappingConfig_Validate(t *testing.T) {
+	c := &MappingConfig{Rules: []*MappingRule{{Target: "["}}}
+	require.Error(t, c.Validate())
+}
+
+func TestInput_ShouldAccept(t *testing.T) {
+	in := NewInput("", "", "", test.Logger, "up", "keep", nil)
+	for range 2 {
+		req := httptest.NewRequest(http.MethodPost, "/up", bytes.NewReader(webhookBody))
+		accepted, err := in.ShouldAccept(req)
+		require.NoError(t, err)
+		require.True(t, accepted)
+	}
+
+	// ShouldAcceptはヘルス状態を変更しないため、同じ通知から引き続きリクエストを組み立てられる
+	req := httptest.NewRequest(http.MethodPost, "/up", bytes.NewReader(webhookBody))
+	got, err := in.BuildScalingRequest(req, &inputs.ScalingRequest{})
+	require.NoError(t, err)
+	require.NotNil(t, got)
+}
+
+func TestInput_BuildScalingRequest_payload(t *testing.T) {
+	mapping := &MappingConfig{
+		Payload: &PayloadConfig{
+			TargetField: "Target",
+			StatusField: "Status",
+			UpColors:    []string{"#36a64f"},
+			DownColors:  []string{"#ff0000"},
+		},
+	}
+	body := `{"attachments":[{"color":"#FF0000","fields":[{"title":"Target","value":"192.0.2.11"}]}]}`
+
+	in := NewInput("", "", "", test.Logger, "up", "keep", mapping)
+	req := httptest.NewRequest(http.MethodPost, "/up", bytes.NewReader([]byte(body)))
+	got, err := in.BuildScalingRequest(req, &inputs.ScalingRequest{})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{LabelTarget: "192.0.2.11", LabelHealth: "down"}, got.Labels)
+}
+
+func TestMappingConfig_Find(t *testing.T) {
+	c := &MappingConfig{Rules: []*MappingRule{
+		{Target: "*.example.com/*", ResourceName: "path"},
+		{Target: "192.0.2.1?", ResourceName: "web"},
+	}}
+
+	tests := []struct {
+		target string
+		want   string
+	}{
+		{target: "www.example.com/healthz", want: "path"},
+		{target: "www.example.com/api/healthz", want: "path"},
+		{target: "192.0.2.11", want: "web"},
+		{target: "192.0.2.1/24", want: ""},
+		{target: "198.51.100.1", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			var got string
+			if rule := c.Find(tt.target); rule != nil {
+				got = rule.ResourceName
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
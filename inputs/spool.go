@@ -0,0 +1,273 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inputs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultSpoolTTL           = 300 // 秒
+	defaultSpoolFlushInterval = 10  // 秒
+	spoolFileExt              = ".json"
+)
+
+// SpoolConfig スプールの設定
+//
+// 再送しても送信できなかったリクエストをDirに保存しておき、Coreへ接続できるようになった時点で送信する。
+// 保存からTTL秒を経過したリクエストは古いスケール要求とみなして送信せずに破棄する
+type SpoolConfig struct {
+	// Dir リクエストを保存するディレクトリ、存在しない場合は作成する
+	Dir string `yaml:"dir" validate:"required"`
+	// TTL 保存したリクエストの有効期間(秒数)、省略時は300秒
+	TTL int `yaml:"ttl" validate:"omitempty,min=1"`
+	// FlushInterval 保存したリクエストの送信を試みる間隔(秒数)、省略時は10秒
+	FlushInterval int `yaml:"flush_interval" validate:"omitempty,min=1"`
+}
+
+// spoolEntry スプールに保存する1リクエスト分のデータ
+type spoolEntry struct {
+	CreatedAt   time.Time       `json:"created_at"`
+	Destination string          `json:"destination,omitempty"`
+	Request     *ScalingRequest `json:"request"`
+}
+
+type spool struct {
+	dir           string
+	ttl           time.Duration
+	flushInterval time.Duration
+	logger        *slog.Logger
+
+	// flushMu 同じリクエストを重複して送信しないようにflushを直列化する
+	flushMu sync.Mutex
+
+	// mu スプールディレクトリへの書き込みと読み込みを保護する
+	mu  sync.Mutex
+	seq uint64
+	now func() time.Time
+}
+
+func newSpool(conf *SpoolConfig, logger *slog.Logger) (*spool, error) {
+	if err := os.MkdirAll(conf.Dir, 0700); err != nil {
+		return nil, err
+	}
+	s := &spool{
+		dir:           conf.Dir,
+		ttl:           defaultSpoolTTL * time.Second,
+		flushInterval: defaultSpoolFlushInterval * time.Second,
+		logger:        logger,
+		now:           time.Now,
+	}
+	if conf.TTL > 0 {
+		s.ttl = time.Duration(conf.TTL) * time.Second
+	}
+	if conf.FlushInterval > 0 {
+		s.flushInterval = time.Duration(conf.FlushInterval) * time.Second
+	}
+
+	// 前回起動時に送信できなかったリクエストをメトリクスに反映しておく
+	names, err := s.entries()
+	if err != nil {
+		return nil, err
+	}
+	spoolDepth.Set(float64(len(names)))
+	return s, nil
+}
+
+// put リクエストをスプールに保存する
+func (s *spool) put(dest string, scalingReq *ScalingRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	data, err := json.Marshal(&spoolEntry{CreatedAt: now, Destination: dest, Request: scalingReq})
+	if err != nil {
+		return err
+	}
+
+	// 書き込み途中のファイルを読まないように一時ファイルに書き込んでからリネームする
+	tmp, err := os.CreateTemp(s.dir, "*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()           //nolint:errcheck
+		os.Remove(tmp.Name()) //nolint:errcheck
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name()) //nolint:errcheck
+		return err
+	}
+
+	s.seq++
+	// ファイル名の辞書順が保存順となるようにする
+	name := fmt.Sprintf("%020d-%06d%s", now.UnixNano(), s.seq%1000000, spoolFileExt)
+	if err := os.Rename(tmp.Name(), filepath.Join(s.dir, name)); err != nil {
+		os.Remove(tmp.Name()) //nolint:errcheck
+		return err
+	}
+
+	spoolDepth.Inc()
+	return nil
+}
+
+// entries スプールされているファイル名を保存順で返す
+func (s *spool) entries() ([]string, error) {
+	files, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, f := range files {
+		if !f.IsDir() && strings.HasSuffix(f.Name(), spoolFileExt) {
+			names = append(names, f.Name())
+		}
+	}
+	return names, nil
+}
+
+// pendingEntry 送信待ちのスプールされたリクエスト
+type pendingEntry struct {
+	name  string
+	entry *spoolEntry
+}
+
+// flush スプールされているリクエストを保存順に送信する
+//
+// Coreへ接続できなかった場合は残りのリクエストを次回に持ち越す。
+// 送信中もputできるように、送信はmuを保持せずに行う
+func (s *spool) flush(ctx context.Context, send sendFunc) error {
+	s.flushMu.Lock()
+	defer s.flushMu.Unlock()
+
+	defer func() {
+		if names, err := s.entries(); err == nil {
+			spoolDepth.Set(float64(len(names)))
+		}
+	}()
+
+	pendings, err := s.pendings()
+	if err != nil {
+		return err
+	}
+
+	for _, p := range pendings {
+		path := filepath.Join(s.dir, p.name)
+		res, err := send(ctx, p.entry.Destination, p.entry.Request)
+		if err != nil {
+			if isRetriable(err) {
+				return nil
+			}
+			s.logger.Error("spooled request rejected by the Core server", slog.String("file", p.name), slog.Any("error", err))
+			s.drop(path, "rejected")
+			continue
+		}
+
+		s.logger.Info(
+			"spooled request delivered",
+			slog.String("file", p.name),
+			slog.String("status", res.Status.String()),
+			slog.String("job-id", res.ScalingJobId),
+			slog.String("job-message", res.Message),
+		)
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pendings muを保持した状態でスプールされているリクエストを保存順に読み込む
+//
+// 読み込めないリクエストや有効期間を過ぎたリクエストはこの時点で破棄する
+func (s *spool) pendings() ([]*pendingEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	names, err := s.entries()
+	if err != nil {
+		return nil, err
+	}
+
+	var pendings []*pendingEntry
+	for _, name := range names {
+		path := filepath.Join(s.dir, name)
+		entry, err := s.read(path)
+		if err != nil {
+			s.logger.Error("reading spooled request failed", slog.String("file", name), slog.Any("error", err))
+			s.drop(path, "invalid")
+			continue
+		}
+		if s.now().Sub(entry.CreatedAt) > s.ttl {
+			s.logger.Warn("spooled request expired", slog.String("file", name), slog.Time("created-at", entry.CreatedAt))
+			s.drop(path, "expired")
+			continue
+		}
+		pendings = append(pendings, &pendingEntry{name: name, entry: entry})
+	}
+	return pendings, nil
+}
+
+func (s *spool) read(path string) (*spoolEntry, error) {
+	data, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+	entry := &spoolEntry{}
+	if err := json.Unmarshal(data, entry); err != nil {
+		return nil, err
+	}
+	if entry.Request == nil {
+		return nil, fmt.Errorf("request not found in %s", filepath.Base(path))
+	}
+	if err := entry.Request.Validate(); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+func (s *spool) drop(path, reason string) {
+	if err := os.Remove(path); err != nil {
+		s.logger.Error("removing spooled request failed", slog.String("file", filepath.Base(path)), slog.Any("error", err))
+		return
+	}
+	spoolDroppedCounter.WithLabelValues(reason).Inc()
+}
+
+// run ctxがキャンセルされるまでflushInterval毎にflushを行う
+func (s *spool) run(ctx context.Context, send sendFunc) error {
+	ticker := time.NewTicker(s.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := s.flush(ctx, send); err != nil {
+				s.logger.Error("flushing spool failed", slog.Any("error", err))
+			}
+		}
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inputs
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sacloud/autoscaler/request"
+	"github.com/sacloud/autoscaler/test"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_spool_flush(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	requests := []*ScalingRequest{
+		{Source: "default", ResourceName: "first", RequestType: "up", DesiredStateName: "default"},
+		{Source: "default", ResourceName: "second", RequestType: "down", DesiredStateName: "default"},
+	}
+
+	tests := []struct {
+		name          string
+		elapsed       time.Duration
+		errs          []error
+		wantDelivered []string
+		wantRemains   int
+	}{
+		{
+			name:          "delivered in order",
+			elapsed:       time.Minute,
+			wantDelivered: []string{"first", "second"},
+			wantRemains:   0,
+		},
+		{
+			name:        "expired",
+			elapsed:     10 * time.Minute,
+			wantRemains: 0,
+		},
+		{
+			name:          "core is still unreachable",
+			elapsed:       time.Minute,
+			errs:          []error{status.Error(codes.Unavailable, "connection refused")},
+			wantDelivered: []string{"first"},
+			wantRemains:   2,
+		},
+		{
+			name:          "rejected",
+			elapsed:       time.Minute,
+			errs:          []error{status.Error(codes.Unknown, "resource not found")},
+			wantDelivered: []string{"first", "second"},
+			wantRemains:   0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := newSpool(&SpoolConfig{Dir: t.TempDir(), TTL: 300}, test.Logger)
+			if err != nil {
+				t.Fatal(err)
+			}
+			s.now = func() time.Time { return now }
+			for _, r := range requests {
+				if err := s.put("", r); err != nil {
+					t.Fatal(err)
+				}
+			}
+			s.now = func() time.Time { return now.Add(tt.elapsed) }
+
+			f := &fakeSend{errs: tt.errs}
+			if err := s.flush(context.Background(), f.send); err != nil {
+				t.Fatal(err)
+			}
+
+			var delivered []string
+			for _, r := range f.received {
+				delivered = append(delivered, r.ResourceName)
+			}
+			require.Equal(t, tt.wantDelivered, delivered)
+
+			names, err := s.entries()
+			if err != nil {
+				t.Fatal(err)
+			}
+			require.Len(t, names, tt.wantRemains)
+		})
+	}
+}
+
+func Test_spool_flush_invalidEntry(t *testing.T) {
+	dir := t.TempDir()
+	s, err := newSpool(&SpoolConfig{Dir: dir}, test.Logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "00000000000000000001-000001.json"), []byte("invalid"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	f := &fakeSend{}
+	if err := s.flush(context.Background(), f.send); err != nil {
+		t.Fatal(err)
+	}
+	require.Empty(t, f.received)
+
+	names, err := s.entries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Empty(t, names)
+}
+
+func Test_spool_flush_putWhileSending(t *testing.T) {
+	s, err := newSpool(&SpoolConfig{Dir: t.TempDir()}, test.Logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := &ScalingRequest{Source: "default", ResourceName: "default", RequestType: "up", DesiredStateName: "default"}
+	if err := s.put("", req); err != nil {
+		t.Fatal(err)
+	}
+
+	// 送信中に別のgoroutineからputできることを確認する
+	send := func(ctx context.Context, dest string, scalingReq *ScalingRequest) (*request.ScalingResponse, error) {
+		done := make(chan error)
+		go func() {
+			done <- s.put(dest, scalingReq)
+		}()
+		select {
+		case err := <-done:
+			if err != nil {
+				return nil, err
+			}
+		case <-time.After(time.Second):
+			t.Fatal("put blocked while sending spooled requests")
+		}
+		return nil, status.Error(codes.Unavailable, "connection refused")
+	}
+	if err := s.flush(context.Background(), send); err != nil {
+		t.Fatal(err)
+	}
+
+	names, err := s.entries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Len(t, names, 2)
+}
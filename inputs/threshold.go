@@ -0,0 +1,40 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inputs
+
+// DefaultTolerance 閾値の目標値からの許容範囲(比率)のデフォルト値
+const DefaultTolerance = 0.1
+
+// Thresholds 目標値と許容範囲、上限値/下限値から閾値の上限値と下限値を返す、算出できない場合はnil
+//
+// upper/lowerが指定されている場合はその値を、省略された場合はtarget*(1±tolerance)を返す。
+// toleranceがnilの場合はDefaultToleranceを利用する
+func Thresholds(target, tolerance, upper, lower *float64) (*float64, *float64) {
+	if target != nil {
+		t := DefaultTolerance
+		if tolerance != nil {
+			t = *tolerance
+		}
+		if upper == nil {
+			v := *target * (1 + t)
+			upper = &v
+		}
+		if lower == nil {
+			v := *target * (1 - t)
+			lower = &v
+		}
+	}
+	return upper, lower
+}
@@ -0,0 +1,49 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inputs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestThresholds(t *testing.T) {
+	ptr := func(v float64) *float64 { return &v }
+	tests := []struct {
+		name                 string
+		target, tolerance    *float64
+		upper, lower         *float64
+		wantUpper, wantLower *float64
+	}{
+		{name: "empty"},
+		{name: "target", target: ptr(100), wantUpper: ptr(110), wantLower: ptr(90)},
+		{name: "target with tolerance", target: ptr(100), tolerance: ptr(0.5), wantUpper: ptr(150), wantLower: ptr(50)},
+		{name: "upper and lower take precedence", target: ptr(100), upper: ptr(200), wantUpper: ptr(200), wantLower: ptr(90)},
+		{name: "upper only", upper: ptr(200), wantUpper: ptr(200)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			upper, lower := Thresholds(tt.target, tt.tolerance, tt.upper, tt.lower)
+			for _, v := range []struct{ want, got *float64 }{{tt.wantUpper, upper}, {tt.wantLower, lower}} {
+				if v.want == nil {
+					require.Nil(t, v.got)
+					continue
+				}
+				require.InDelta(t, *v.want, *v.got, 0.001)
+			}
+		})
+	}
+}
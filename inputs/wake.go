@@ -0,0 +1,98 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inputs
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/sacloud/autoscaler/defaults"
+)
+
+// WakeConfig /wakeエンドポイントの設定
+//
+// ELBのソーリーサーバやDNSのフックなどから、台数が0になったServerGroupへの最初のリクエストを契機に呼び出すことを想定している
+// 呼び出されると指定の台数へのUpリクエストをCoreへ送信する
+type WakeConfig struct {
+	// Size 起こす際の台数
+	Size uint32 `yaml:"size" validate:"required,min=1"`
+	// ResourceName 対象リソース名、クエリストリング(resource-name)で上書き可能
+	ResourceName string `yaml:"resource_name" validate:"omitempty,printascii,max=1024"`
+}
+
+// WakeSourceName /wakeエンドポイントから送信するリクエストのSource
+const WakeSourceName = "wake"
+
+var allowedWakeQueryStringKeys = []string{"resource-name", "size"}
+
+func (s *server) handleWake(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet && req.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	s.logger.Info("wake request received")
+
+	scalingReq, err := s.wakeRequest(req.URL.Query())
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error())) //nolint:errcheck
+		return
+	}
+	s.handleScalingRequest(req.Context(), w, scalingReq)
+}
+
+// wakeRequest WakeConfigとクエリストリングからCoreへのUpリクエストを組み立てる
+func (s *server) wakeRequest(query url.Values) (*ScalingRequest, error) {
+	for k := range query {
+		found := false
+		for _, allowed := range allowedWakeQueryStringKeys {
+			if k == allowed {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("invalid parameter key: %s", k)
+		}
+	}
+
+	conf := s.config.Wake
+	resourceName := query.Get("resource-name")
+	if resourceName == "" {
+		resourceName = conf.ResourceName
+	}
+	if resourceName == "" {
+		resourceName = defaults.ResourceName
+	}
+	size, found, err := parseUint32Query(query, "size")
+	if err != nil {
+		return nil, err
+	}
+	if !found || size == 0 {
+		size = conf.Size
+	}
+
+	scalingReq := &ScalingRequest{
+		Source:       WakeSourceName,
+		ResourceName: resourceName,
+		RequestType:  "up",
+		DesiredSpec:  &DesiredSpec{Size: size},
+	}
+	if err := scalingReq.Validate(); err != nil {
+		return nil, err
+	}
+	return scalingReq, nil
+}
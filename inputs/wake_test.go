@@ -0,0 +1,91 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inputs
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_server_wakeRequest(t *testing.T) {
+	server, err := newServer(&fakeInput{}, &Config{Wake: &WakeConfig{Size: 2, ResourceName: "web"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		query   string
+		want    *ScalingRequest
+		wantErr bool
+	}{
+		{
+			name:  "default",
+			query: "",
+			want: &ScalingRequest{
+				Source:       WakeSourceName,
+				ResourceName: "web",
+				RequestType:  "up",
+				DesiredSpec:  &DesiredSpec{Size: 2},
+			},
+		},
+		{
+			name:  "with query string",
+			query: "resource-name=app&size=3",
+			want: &ScalingRequest{
+				Source:       WakeSourceName,
+				ResourceName: "app",
+				RequestType:  "up",
+				DesiredSpec:  &DesiredSpec{Size: 3},
+			},
+		},
+		{
+			name:    "invalid size",
+			query:   "size=foo",
+			wantErr: true,
+		},
+		{
+			name:    "invalid key",
+			query:   "desired-state-name=large",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := url.ParseQuery(tt.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := server.wakeRequest(query)
+			require.Equal(t, tt.wantErr, err != nil, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_server_wake_disabled(t *testing.T) {
+	server, err := newServer(&fakeInput{}, &Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+	server.Handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/wake", nil))
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
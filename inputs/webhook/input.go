@@ -16,15 +16,30 @@ package webhook
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
+	"os"
 	"os/exec"
+	"strings"
+	"time"
 
+	"github.com/sacloud/autoscaler/inputs"
 	"github.com/sacloud/autoscaler/version"
 )
 
+// 実行ファイルへリクエストの内容を渡す際の環境変数名
+const (
+	EnvMethod       = "AUTOSCALER_WEBHOOK_METHOD"
+	EnvPath         = "AUTOSCALER_WEBHOOK_PATH"
+	EnvQuery        = "AUTOSCALER_WEBHOOK_QUERY"
+	EnvRequestType  = "AUTOSCALER_WEBHOOK_REQUEST_TYPE"
+	EnvHeaderPrefix = "AUTOSCALER_WEBHOOK_HEADER_"
+)
+
 type Input struct {
 	dest       string
 	addr       string
@@ -33,9 +48,15 @@ type Input struct {
 
 	acceptHTTPMethods []string
 	executablePath    string
+	timeout           time.Duration
+	decisionOutput    bool
 }
 
-func NewInput(dest, addr, configPath string, logger *slog.Logger, acceptHTTPMethods []string, executablePath string) (*Input, error) {
+// NewInput webhook Inputを作成する
+//
+// timeoutが0の場合は実行ファイルの終了を待ち続ける。
+// decisionOutputがtrueの場合、実行ファイルの標準出力をJSON形式の判定結果(Decision)として扱う
+func NewInput(dest, addr, configPath string, logger *slog.Logger, acceptHTTPMethods []string, executablePath string, timeout time.Duration, decisionOutput bool) (*Input, error) {
 	if len(acceptHTTPMethods) == 0 {
 		return nil, fmt.Errorf("acceptHTTPMethod: required")
 	}
@@ -56,6 +77,8 @@ func NewInput(dest, addr, configPath string, logger *slog.Logger, acceptHTTPMeth
 
 		acceptHTTPMethods: acceptHTTPMethods,
 		executablePath:    execPath,
+		timeout:           timeout,
+		decisionOutput:    decisionOutput,
 	}, nil
 }
 func (in *Input) Name() string {
@@ -82,17 +105,52 @@ func (in *Input) GetLogger() *slog.Logger {
 	return in.logger
 }
 
+// ShouldAccept inputs.Inputの実装
+//
+// BuildScalingRequestを実装しているためinputs.serverからは呼ばれない。
+// 実行ファイルはBuildScalingRequestでのみ実行し、ここではHTTPメソッドのみを判定する
 func (in *Input) ShouldAccept(req *http.Request) (bool, error) {
+	return in.allowedMethod(req), nil
+}
+
+// Decision 実行ファイルが標準出力へ出力する判定結果
+//
+// 省略した項目はURLのパスとクエリストリングから組み立てた値が利用される
+type Decision struct {
+	// Accept falseの場合はリクエストを無視する、省略時はtrue
+	Accept           *bool             `json:"accept"`
+	RequestType      string            `json:"request_type"`
+	ResourceName     string            `json:"resource_name"`
+	DesiredStateName string            `json:"desired_state_name"`
+	Step             *uint32           `json:"step"`
+	Labels           map[string]string `json:"labels"`
+	PayloadRef       string            `json:"payload_ref"`
+}
+
+// BuildScalingRequest inputs.ScalingRequestBuilderの実装
+func (in *Input) BuildScalingRequest(req *http.Request, base *inputs.ScalingRequest) (*inputs.ScalingRequest, error) {
 	if !in.allowedMethod(req) {
-		return false, nil
+		return nil, nil
 	}
 
 	body, err := io.ReadAll(req.Body)
 	if err != nil {
-		return false, err
+		return nil, err
+	}
+
+	stdout, err := in.execCommand(req.Context(), body, in.commandEnv(req, base))
+	if err != nil {
+		return nil, err
+	}
+	if !in.decisionOutput {
+		return base, nil
 	}
 
-	return in.execCommand(body)
+	decision, err := in.parseDecision(stdout)
+	if err != nil {
+		return nil, err
+	}
+	return decision.apply(base), nil
 }
 
 func (in *Input) allowedMethod(req *http.Request) bool {
@@ -104,13 +162,81 @@ func (in *Input) allowedMethod(req *http.Request) bool {
 	return false
 }
 
-func (in *Input) execCommand(body []byte) (bool, error) {
-	cmd := exec.Command(in.executablePath, string(body)) //nolint: gosec
-	bodyReader := bytes.NewReader(body)
-	cmd.Stdin = bodyReader
+// commandEnv 実行ファイルへ渡す環境変数を組み立てる
+//
+// ヘッダはAUTOSCALER_WEBHOOK_HEADER_<大文字にして-を_に置き換えたヘッダ名>として渡す。複数の値を持つ場合はカンマ区切りとなる
+func (in *Input) commandEnv(req *http.Request, base *inputs.ScalingRequest) []string {
+	env := []string{
+		EnvMethod + "=" + req.Method,
+		EnvPath + "=" + req.URL.Path,
+		EnvQuery + "=" + req.URL.RawQuery,
+		EnvRequestType + "=" + base.RequestType,
+	}
+	for k, v := range req.Header {
+		name := strings.ToUpper(strings.ReplaceAll(k, "-", "_"))
+		env = append(env, EnvHeaderPrefix+name+"="+strings.Join(v, ","))
+	}
+	return env
+}
+
+// execCommand 実行ファイルをbodyを引数/標準入力として実行し、標準出力の内容を返す
+//
+// 終了ステータスが0以外の場合やタイムアウトした場合はエラーを返す
+func (in *Input) execCommand(ctx context.Context, body []byte, env []string) ([]byte, error) {
+	if in.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, in.timeout)
+		defer cancel()
+	}
+
+	cmd := exec.CommandContext(ctx, in.executablePath, string(body)) //nolint: gosec
+	cmd.Stdin = bytes.NewReader(body)
+	cmd.Env = append(os.Environ(), env...)
+	stdout := &bytes.Buffer{}
+	cmd.Stdout = stdout
+	// タイムアウト時に子プロセスが標準出力を保持したままでもWaitが返るようにする
+	cmd.WaitDelay = time.Second
 
 	if err := cmd.Run(); err != nil {
-		return false, fmt.Errorf("command %q returned non zero status: %s", in.executablePath, err)
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, fmt.Errorf("command %q timed out after %s", in.executablePath, in.timeout)
+		}
+		return nil, fmt.Errorf("command %q returned non zero status: %s", in.executablePath, err)
+	}
+	return stdout.Bytes(), nil
+}
+
+func (in *Input) parseDecision(stdout []byte) (*Decision, error) {
+	decision := &Decision{}
+	if len(bytes.TrimSpace(stdout)) == 0 {
+		return decision, nil
+	}
+	if err := json.Unmarshal(stdout, decision); err != nil {
+		return nil, fmt.Errorf("command %q returned invalid decision: %s", in.executablePath, err)
+	}
+	return decision, nil
+}
+
+// apply 判定結果をbaseへ反映したScalingRequestを返す、受け付けない場合はnilを返す
+func (d *Decision) apply(base *inputs.ScalingRequest) *inputs.ScalingRequest {
+	if d.Accept != nil && !*d.Accept {
+		return nil
+	}
+	req := *base
+	if d.RequestType != "" {
+		req.RequestType = d.RequestType
+	}
+	if d.ResourceName != "" {
+		req.ResourceName = d.ResourceName
+	}
+	if d.DesiredStateName != "" {
+		req.DesiredStateName = d.DesiredStateName
+	}
+	if d.Step != nil {
+		req.Step = *d.Step
+	}
+	if d.PayloadRef != "" {
+		req.PayloadRef = d.PayloadRef
 	}
-	return cmd.ProcessState.Success(), nil
+	return req.WithLabels(d.Labels)
 }
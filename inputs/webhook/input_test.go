@@ -18,9 +18,15 @@
 package webhook
 
 import (
+	"bytes"
+	"context"
 	_ "embed"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/sacloud/autoscaler/inputs"
 	"github.com/sacloud/autoscaler/test"
 	"github.com/stretchr/testify/require"
 )
@@ -68,16 +74,129 @@ func TestInput_execCommand(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			in, err := NewInput("", "", "", test.Logger, []string{"POST"}, tt.executablePath)
+			in, err := NewInput("", "", "", test.Logger, []string{"POST"}, tt.executablePath, 0, false)
 			if err != nil {
 				require.EqualError(t, err, tt.err)
 				return
 			}
 
-			got, err := in.execCommand(webhookBody)
+			_, err = in.execCommand(context.Background(), webhookBody, nil)
 			if err != nil {
 				require.EqualError(t, err, tt.err)
 			}
+			require.Equal(t, tt.want, err == nil)
+		})
+	}
+}
+
+func TestInput_execCommand_timeout(t *testing.T) {
+	in, err := NewInput("", "", "", test.Logger, []string{"POST"}, "test/sleep.sh", 100*time.Millisecond, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = in.execCommand(context.Background(), webhookBody, nil)
+	require.EqualError(t, err, `command "test/sleep.sh" timed out after 100ms`)
+}
+
+func TestInput_ShouldAccept(t *testing.T) {
+	// 実行ファイルは実行されないため、実行すると失敗する場合でも受け付ける
+	in, err := NewInput("", "", "", test.Logger, []string{"POST"}, "test/not-alerting.sh", 0, false)
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		method string
+		want   bool
+	}{
+		{method: http.MethodPost, want: true},
+		{method: http.MethodGet, want: false},
+	} {
+		t.Run(tt.method, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/", bytes.NewReader(webhookBody))
+			got, err := in.ShouldAccept(req)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestInput_BuildScalingRequest(t *testing.T) {
+	base := &inputs.ScalingRequest{
+		Source:           "default",
+		ResourceName:     "default",
+		RequestType:      "up",
+		DesiredStateName: "default",
+	}
+
+	tests := []struct {
+		name           string
+		executablePath string
+		decisionOutput bool
+		method         string
+		headers        map[string]string
+		want           *inputs.ScalingRequest
+		err            string
+	}{
+		{
+			name:           "method not allowed",
+			executablePath: "test/alerting.sh",
+			method:         http.MethodGet,
+			want:           nil,
+		},
+		{
+			name:           "without decision output",
+			executablePath: "test/alerting.sh",
+			method:         http.MethodPost,
+			want:           base,
+		},
+		{
+			name:           "with decision output",
+			executablePath: "test/decision.sh",
+			decisionOutput: true,
+			method:         http.MethodPost,
+			headers:        map[string]string{"X-Desired-State": "large"},
+			want: &inputs.ScalingRequest{
+				Source:           "default",
+				ResourceName:     "app01",
+				RequestType:      "up",
+				DesiredStateName: "large",
+				Step:             2,
+				Labels:           map[string]string{"method": "POST"},
+			},
+		},
+		{
+			name:           "not accepted by decision",
+			executablePath: "test/decision.sh",
+			decisionOutput: true,
+			method:         http.MethodPost,
+			headers:        map[string]string{"X-Autoscaler-Accept": "false"},
+			want:           nil,
+		},
+		{
+			name:           "invalid decision",
+			executablePath: "test/invalid-decision.sh",
+			decisionOutput: true,
+			method:         http.MethodPost,
+			err:            `command "test/invalid-decision.sh" returned invalid decision: invalid character 'o' in literal null (expecting 'u')`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in, err := NewInput("", "", "", test.Logger, []string{"POST"}, tt.executablePath, time.Minute, tt.decisionOutput)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req := httptest.NewRequest(tt.method, "/up?resource-name=default", bytes.NewReader(webhookBody))
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+
+			got, err := in.BuildScalingRequest(req, base)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
 			require.Equal(t, tt.want, got)
 		})
 	}
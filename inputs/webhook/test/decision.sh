@@ -0,0 +1,30 @@
+#!/bin/sh
+# Copyright 2021-2025 The sacloud/autoscaler Authors
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#      http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# 環境変数とクエリストリングからリクエスト内容を決定する
+if [ "$AUTOSCALER_WEBHOOK_HEADER_X_AUTOSCALER_ACCEPT" = "false" ]; then
+  echo '{"accept": false}'
+  exit 0
+fi
+
+cat <<EOT
+{
+  "request_type": "$AUTOSCALER_WEBHOOK_REQUEST_TYPE",
+  "resource_name": "$(jq -r '.host.name')",
+  "desired_state_name": "$AUTOSCALER_WEBHOOK_HEADER_X_DESIRED_STATE",
+  "step": 2,
+  "labels": {"method": "$AUTOSCALER_WEBHOOK_METHOD"}
+}
+EOT
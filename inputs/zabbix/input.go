@@ -15,25 +15,33 @@
 package zabbix
 
 import (
+	"fmt"
 	"log/slog"
 	"net/http"
+	"strconv"
 
+	"github.com/sacloud/autoscaler/inputs"
 	"github.com/sacloud/autoscaler/version"
 )
 
 type Input struct {
+	inputs.ScalingRequestBuilderBase
+
 	dest       string
 	addr       string
 	configPath string
 	logger     *slog.Logger
+
+	minSeverity int
 }
 
-func NewInput(dest, addr, configPath string, logger *slog.Logger) *Input {
+func NewInput(dest, addr, configPath string, logger *slog.Logger, minSeverity int) *Input {
 	return &Input{
-		dest:       dest,
-		addr:       addr,
-		configPath: configPath,
-		logger:     logger,
+		dest:        dest,
+		addr:        addr,
+		configPath:  configPath,
+		logger:      logger,
+		minSeverity: minSeverity,
 	}
 }
 
@@ -61,10 +69,94 @@ func (in *Input) GetLogger() *slog.Logger {
 	return in.logger
 }
 
-func (in *Input) ShouldAccept(req *http.Request) (bool, error) {
-	if req.Method == http.MethodPost {
-		// POSTでリクエストされたらtrueを返す(Bodyの中は読まない)
-		return true, nil
+// BuildScalingRequest inputs.ScalingRequestBuilderの実装
+//
+// PROBLEMイベントのみ受け入れ、トリガーのタグが指定されていた場合はクエリストリングの値より優先する
+func (in *Input) BuildScalingRequest(req *http.Request, base *inputs.ScalingRequest) (*inputs.ScalingRequest, error) {
+	received, err := in.parseBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if received == nil || !in.accept(received) {
+		return nil, nil
+	}
+
+	tags := make(map[string]string)
+	for _, tag := range received.Tags {
+		tags[tag.Tag] = tag.Value
+	}
+	return base.WithTags(tags).WithLabels(tags), nil
+}
+
+func (in *Input) parseBody(req *http.Request) (*zabbixWebhookBody, error) {
+	var received zabbixWebhookBody
+	if ok, err := inputs.DecodeJSONBody(req, &received, http.MethodPost); !ok || err != nil {
+		return nil, err
+	}
+	if err := received.validate(); err != nil {
+		return nil, err
+	}
+	return &received, nil
+}
+
+func (in *Input) accept(received *zabbixWebhookBody) bool {
+	if !received.isProblem() {
+		in.logger.Debug("not a problem event", slog.String("event-id", received.EventID))
+		return false
+	}
+	severity, _ := strconv.Atoi(received.EventNSeverity) // validate済み
+	if severity < in.minSeverity {
+		in.logger.Debug("severity is lower than min-severity",
+			slog.String("event-id", received.EventID),
+			slog.Int("severity", severity),
+			slog.Int("min-severity", in.minSeverity),
+		)
+		return false
+	}
+	return true
+}
+
+// zabbixWebhookBody examples/zabbix/script.jsから送信されるWebhookのペイロード
+//
+// 各値はZabbixのマクロを展開した文字列が格納される
+type zabbixWebhookBody struct {
+	EventID           string             `json:"event_id"`            // {EVENT.ID}
+	EventName         string             `json:"event_name"`          // {EVENT.NAME}
+	EventValue        string             `json:"event_value"`         // {EVENT.VALUE} 1: 障害, 0: 復旧
+	EventUpdateStatus string             `json:"event_update_status"` // {EVENT.UPDATE.STATUS} 1: 確認やコメントなどの更新
+	EventSeverity     string             `json:"event_severity"`      // {EVENT.SEVERITY}
+	EventNSeverity    string             `json:"event_nseverity"`     // {EVENT.NSEVERITY} 0-5
+	TriggerID         string             `json:"trigger_id"`          // {TRIGGER.ID}
+	TriggerStatus     string             `json:"trigger_status"`      // {TRIGGER.STATUS} PROBLEM or OK
+	HostName          string             `json:"host_name"`           // {HOST.NAME}
+	Tags              []zabbixWebhookTag `json:"tags"`                // {EVENT.TAGSJSON}
+}
+
+type zabbixWebhookTag struct {
+	Tag   string `json:"tag"`
+	Value string `json:"value"`
+}
+
+func (b *zabbixWebhookBody) validate() error {
+	switch b.EventValue {
+	case "0", "1":
+	default:
+		return fmt.Errorf("invalid event_value: %q", b.EventValue)
+	}
+
+	if b.EventNSeverity == "" {
+		b.EventNSeverity = "0"
+	}
+	severity, err := strconv.Atoi(b.EventNSeverity)
+	if err != nil || severity < 0 || severity > 5 {
+		return fmt.Errorf("invalid event_nseverity: %q", b.EventNSeverity)
+	}
+	return nil
+}
+
+func (b *zabbixWebhookBody) isProblem() bool {
+	if b.EventValue != "1" || b.EventUpdateStatus == "1" {
+		return false
 	}
-	return false, nil
+	return b.TriggerStatus == "" || b.TriggerStatus == "PROBLEM"
 }
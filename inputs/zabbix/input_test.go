@@ -0,0 +1,146 @@
+// Copyright 2021-2025 The sacloud/autoscaler Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zabbix
+
+import (
+	"bytes"
+	_ "embed"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sacloud/autoscaler/inputs"
+	"github.com/sacloud/autoscaler/test"
+	"github.com/stretchr/testify/require"
+)
+
+//go:embed test/webhook.json
+var webhookBody []byte
+
+func TestInput_BuildScalingRequest(t *testing.T) {
+	base := &inputs.ScalingRequest{
+		Source:           "default",
+		ResourceName:     "default",
+		RequestType:      "up",
+		DesiredStateName: "",
+	}
+
+	tests := []struct {
+		name        string
+		method      string
+		body        string
+		minSeverity int
+		want        *inputs.ScalingRequest
+		wantErr     bool
+	}{
+		{
+			name:   "problem",
+			method: http.MethodPost,
+			body:   string(webhookBody),
+			want: &inputs.ScalingRequest{
+				Source:       "default",
+				ResourceName: "default",
+				RequestType:  "up",
+				Labels: map[string]string{
+					"autoscaler_resource_name": "default",
+				},
+			},
+		},
+		{
+			name:   "not POST",
+			method: http.MethodGet,
+			body:   string(webhookBody),
+			want:   nil,
+		},
+		{
+			name:   "resolved",
+			method: http.MethodPost,
+			body:   `{"event_value":"0", "event_nseverity":"4", "trigger_status":"OK"}`,
+			want:   nil,
+		},
+		{
+			name:   "updated",
+			method: http.MethodPost,
+			body:   `{"event_value":"1", "event_update_status":"1", "event_nseverity":"4", "trigger_status":"PROBLEM"}`,
+			want:   nil,
+		},
+		{
+			name:        "lower severity",
+			method:      http.MethodPost,
+			body:        `{"event_value":"1", "event_nseverity":"2", "trigger_status":"PROBLEM"}`,
+			minSeverity: 3,
+			want:        nil,
+		},
+		{
+			name:        "higher severity",
+			method:      http.MethodPost,
+			body:        `{"event_value":"1", "event_nseverity":"3", "trigger_status":"PROBLEM"}`,
+			minSeverity: 3,
+			want:        base,
+		},
+		{
+			name:   "with tags",
+			method: http.MethodPost,
+			body: `{"event_value":"1", "event_nseverity":"4", "trigger_status":"PROBLEM", "tags":[
+				{"tag":"autoscaler_source", "value":"zabbix"},
+				{"tag":"autoscaler_resource_name", "value":"web"},
+				{"tag":"autoscaler_request_type", "value":"down"},
+				{"tag":"autoscaler_desired_state_name", "value":"small"},
+				{"tag":"unknown", "value":"foo"}
+			]}`,
+			want: &inputs.ScalingRequest{
+				Source:           "zabbix",
+				ResourceName:     "web",
+				RequestType:      "up",
+				DesiredStateName: "small",
+				Labels: map[string]string{
+					"autoscaler_source":             "zabbix",
+					"autoscaler_resource_name":      "web",
+					"autoscaler_request_type":       "down",
+					"autoscaler_desired_state_name": "small",
+					"unknown":                       "foo",
+				},
+			},
+		},
+		{
+			name:    "invalid event_value",
+			method:  http.MethodPost,
+			body:    `{"event_value":"2"}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid event_nseverity",
+			method:  http.MethodPost,
+			body:    `{"event_value":"1", "event_nseverity":"6"}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid json",
+			method:  http.MethodPost,
+			body:    `{`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := NewInput("", "", "", test.Logger, tt.minSeverity)
+			req := httptest.NewRequest(tt.method, "/up", bytes.NewReader([]byte(tt.body)))
+
+			got, err := in.BuildScalingRequest(req, base)
+			require.Equal(t, tt.wantErr, err != nil, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -45,6 +45,16 @@ func InitErrorCount(component string) {
 	errors.WithLabelValues(component)
 }
 
+var driftedInstances = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "sacloud_autoscaler_server_group_drifted_instances",
+	Help: "The number of ServerGroup instances that do not match the template",
+}, []string{"resource"})
+
+// SetDriftedInstances sacloud_autoscaler_server_group_drifted_instancesに指定のServerGroupのドリフトしているサーバ台数を設定する
+func SetDriftedInstances(resource string, count int) {
+	driftedInstances.WithLabelValues(resource).Set(float64(count))
+}
+
 // Server メトリクス収集用の*http.Serverラッパー
 type Server struct {
 	ListenAddress string
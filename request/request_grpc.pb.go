@@ -43,6 +43,22 @@ type ScalingServiceClient interface {
 	Down(ctx context.Context, in *ScalingRequest, opts ...grpc.CallOption) (*ScalingResponse, error)
 	// Keep 台数維持のリクエスト
 	Keep(ctx context.Context, in *ScalingRequest, opts ...grpc.CallOption) (*ScalingResponse, error)
+	// Refresh ServerGroupのインスタンスリフレッシュのリクエスト
+	//
+	// テンプレートが変更された既存サーバを新しいサーバに入れ替える。desired_state_name/step/desired_specは無視される
+	Refresh(ctx context.Context, in *ScalingRequest, opts ...grpc.CallOption) (*ScalingResponse, error)
+	// Drift ServerGroupのサーバとテンプレートとの差分(ドリフト)の検出
+	//
+	// 参照のみを行い、サーバへの変更は行わない
+	Drift(ctx context.Context, in *DriftRequest, opts ...grpc.CallOption) (*DriftResponse, error)
+	// CompleteLifecycleAction ServerGroupのライフサイクルフックで完了待ちとなっているサーバの処理を再開させる
+	//
+	// 完了待ちのアクションが見つからない場合はエラーを返す
+	CompleteLifecycleAction(ctx context.Context, in *CompleteLifecycleActionRequest, opts ...grpc.CallOption) (*CompleteLifecycleActionResponse, error)
+	// Adopt 既存サーバをServerGroupに取り込むリクエスト
+	//
+	// adoptionが設定されたServerGroupのみ対象となる。dry_runがtrueの場合は取り込み内容の算出のみを行い、サーバへの変更は行わない
+	Adopt(ctx context.Context, in *AdoptRequest, opts ...grpc.CallOption) (*AdoptResponse, error)
 }
 
 type scalingServiceClient struct {
@@ -80,6 +96,42 @@ func (c *scalingServiceClient) Keep(ctx context.Context, in *ScalingRequest, opt
 	return out, nil
 }
 
+func (c *scalingServiceClient) Refresh(ctx context.Context, in *ScalingRequest, opts ...grpc.CallOption) (*ScalingResponse, error) {
+	out := new(ScalingResponse)
+	err := c.cc.Invoke(ctx, "/autoscaler.ScalingService/Refresh", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scalingServiceClient) Drift(ctx context.Context, in *DriftRequest, opts ...grpc.CallOption) (*DriftResponse, error) {
+	out := new(DriftResponse)
+	err := c.cc.Invoke(ctx, "/autoscaler.ScalingService/Drift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scalingServiceClient) CompleteLifecycleAction(ctx context.Context, in *CompleteLifecycleActionRequest, opts ...grpc.CallOption) (*CompleteLifecycleActionResponse, error) {
+	out := new(CompleteLifecycleActionResponse)
+	err := c.cc.Invoke(ctx, "/autoscaler.ScalingService/CompleteLifecycleAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scalingServiceClient) Adopt(ctx context.Context, in *AdoptRequest, opts ...grpc.CallOption) (*AdoptResponse, error) {
+	out := new(AdoptResponse)
+	err := c.cc.Invoke(ctx, "/autoscaler.ScalingService/Adopt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ScalingServiceServer is the server API for ScalingService service.
 // All implementations must embed UnimplementedScalingServiceServer
 // for forward compatibility
@@ -90,6 +142,22 @@ type ScalingServiceServer interface {
 	Down(context.Context, *ScalingRequest) (*ScalingResponse, error)
 	// Keep 台数維持のリクエスト
 	Keep(context.Context, *ScalingRequest) (*ScalingResponse, error)
+	// Refresh ServerGroupのインスタンスリフレッシュのリクエスト
+	//
+	// テンプレートが変更された既存サーバを新しいサーバに入れ替える。desired_state_name/step/desired_specは無視される
+	Refresh(context.Context, *ScalingRequest) (*ScalingResponse, error)
+	// Drift ServerGroupのサーバとテンプレートとの差分(ドリフト)の検出
+	//
+	// 参照のみを行い、サーバへの変更は行わない
+	Drift(context.Context, *DriftRequest) (*DriftResponse, error)
+	// CompleteLifecycleAction ServerGroupのライフサイクルフックで完了待ちとなっているサーバの処理を再開させる
+	//
+	// 完了待ちのアクションが見つからない場合はエラーを返す
+	CompleteLifecycleAction(context.Context, *CompleteLifecycleActionRequest) (*CompleteLifecycleActionResponse, error)
+	// Adopt 既存サーバをServerGroupに取り込むリクエスト
+	//
+	// adoptionが設定されたServerGroupのみ対象となる。dry_runがtrueの場合は取り込み内容の算出のみを行い、サーバへの変更は行わない
+	Adopt(context.Context, *AdoptRequest) (*AdoptResponse, error)
 	mustEmbedUnimplementedScalingServiceServer()
 }
 
@@ -106,6 +174,18 @@ func (UnimplementedScalingServiceServer) Down(context.Context, *ScalingRequest)
 func (UnimplementedScalingServiceServer) Keep(context.Context, *ScalingRequest) (*ScalingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Keep not implemented")
 }
+func (UnimplementedScalingServiceServer) Refresh(context.Context, *ScalingRequest) (*ScalingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Refresh not implemented")
+}
+func (UnimplementedScalingServiceServer) Drift(context.Context, *DriftRequest) (*DriftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Drift not implemented")
+}
+func (UnimplementedScalingServiceServer) CompleteLifecycleAction(context.Context, *CompleteLifecycleActionRequest) (*CompleteLifecycleActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteLifecycleAction not implemented")
+}
+func (UnimplementedScalingServiceServer) Adopt(context.Context, *AdoptRequest) (*AdoptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Adopt not implemented")
+}
 func (UnimplementedScalingServiceServer) mustEmbedUnimplementedScalingServiceServer() {}
 
 // UnsafeScalingServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -173,6 +253,78 @@ func _ScalingService_Keep_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScalingService_Refresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScalingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScalingServiceServer).Refresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/autoscaler.ScalingService/Refresh",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScalingServiceServer).Refresh(ctx, req.(*ScalingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScalingService_Drift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScalingServiceServer).Drift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/autoscaler.ScalingService/Drift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScalingServiceServer).Drift(ctx, req.(*DriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScalingService_CompleteLifecycleAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteLifecycleActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScalingServiceServer).CompleteLifecycleAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/autoscaler.ScalingService/CompleteLifecycleAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScalingServiceServer).CompleteLifecycleAction(ctx, req.(*CompleteLifecycleActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScalingService_Adopt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdoptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScalingServiceServer).Adopt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/autoscaler.ScalingService/Adopt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScalingServiceServer).Adopt(ctx, req.(*AdoptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ScalingService_ServiceDesc is the grpc.ServiceDesc for ScalingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -192,6 +344,22 @@ var ScalingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Keep",
 			Handler:    _ScalingService_Keep_Handler,
 		},
+		{
+			MethodName: "Refresh",
+			Handler:    _ScalingService_Refresh_Handler,
+		},
+		{
+			MethodName: "Drift",
+			Handler:    _ScalingService_Drift_Handler,
+		},
+		{
+			MethodName: "CompleteLifecycleAction",
+			Handler:    _ScalingService_CompleteLifecycleAction_Handler,
+		},
+		{
+			MethodName: "Adopt",
+			Handler:    _ScalingService_Adopt_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "request.proto",